@@ -0,0 +1,129 @@
+package async
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// TimerClock is a Clock that can also schedule calls, which lets Batch run
+// on fake time.
+type TimerClock interface {
+	Clock
+	// AfterFunc calls f in its own goroutine once d has elapsed. The
+	// returned stop function cancels the call and reports whether it did
+	// so before f was started.
+	AfterFunc(d time.Duration, f func()) (stop func() bool)
+}
+
+func (systemClock) AfterFunc(d time.Duration, f func()) func() bool {
+	return time.AfterFunc(d, f).Stop
+}
+
+// BatchOption configures a Batch.
+type BatchOption func(*batchOptions)
+
+type batchOptions struct {
+	clock TimerClock
+}
+
+// WithBatchClock makes the batch schedule its timeouts on c instead of the
+// wall clock.
+func WithBatchClock(c TimerClock) BatchOption {
+	return func(o *batchOptions) {
+		o.clock = c
+	}
+}
+
+// Batch groups submitted items and passes them to a flush function once
+// maxSize items have accumulated or maxDelay has elapsed since the first
+// item of the current batch, whichever comes first. Batches are flushed one
+// at a time and in submission order. It is safe for concurrent use.
+type Batch[T any] struct {
+	mu       sync.Mutex
+	flushMu  sync.Mutex // held while flush runs; acquired with mu held
+	flush    func([]T)
+	clock    TimerClock
+	maxSize  int
+	maxDelay time.Duration
+
+	items  []T
+	gen    int // incremented with every batch taken, to ignore stale timers
+	stop   func() bool
+	closed bool
+}
+
+// NewBatch returns a batch that calls flush with at most maxSize items. If
+// maxDelay is not positive, batches are only flushed when full or on Close.
+// NewBatch panics if maxSize is not positive.
+func NewBatch[T any](maxSize int, maxDelay time.Duration, flush func([]T), opts ...BatchOption) *Batch[T] {
+	if maxSize <= 0 {
+		panic(fmt.Sprintf("async: invalid Batch size %d", maxSize))
+	}
+	o := batchOptions{clock: systemClock{}}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &Batch[T]{flush: flush, clock: o.clock, maxSize: maxSize, maxDelay: maxDelay}
+}
+
+// Submit adds v to the current batch and flushes it if it is full, in
+// which case Submit waits for flush to return. flush must not call Submit.
+// Submit panics if the batch has been closed.
+func (b *Batch[T]) Submit(v T) {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		panic("async: Submit on closed Batch")
+	}
+	b.items = append(b.items, v)
+	if len(b.items) == 1 && b.maxDelay > 0 {
+		gen := b.gen
+		b.stop = b.clock.AfterFunc(b.maxDelay, func() { b.timeout(gen) })
+	}
+	if len(b.items) < b.maxSize {
+		b.mu.Unlock()
+		return
+	}
+	b.flushLocked()
+}
+
+// timeout flushes the batch numbered gen if it is still pending.
+func (b *Batch[T]) timeout(gen int) {
+	b.mu.Lock()
+	if gen != b.gen || len(b.items) == 0 {
+		b.mu.Unlock()
+		return
+	}
+	b.flushLocked()
+}
+
+// flushLocked takes the current batch, releases b.mu and flushes the
+// batch. Taking b.flushMu before releasing b.mu keeps batches in order.
+func (b *Batch[T]) flushLocked() {
+	items := b.items
+	b.items = nil
+	b.gen++
+	if b.stop != nil {
+		b.stop()
+		b.stop = nil
+	}
+	b.flushMu.Lock()
+	b.mu.Unlock()
+	defer b.flushMu.Unlock()
+	if len(items) > 0 {
+		b.flush(items)
+	}
+}
+
+// Close flushes the remaining items and stops the pending timeout. Calling
+// Close more than once has no effect.
+func (b *Batch[T]) Close() {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return
+	}
+	b.closed = true
+	b.flushLocked()
+}
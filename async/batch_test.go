@@ -0,0 +1,156 @@
+package async
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// recorder collects the batches passed to flush.
+type recorder[T any] struct {
+	mu      sync.Mutex
+	batches [][]T
+}
+
+func (r *recorder[T]) flush(items []T) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.batches = append(r.batches, items)
+}
+
+func (r *recorder[T]) get() [][]T {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([][]T(nil), r.batches...)
+}
+
+func TestBatchFlushesWhenFull(t *testing.T) {
+	var r recorder[int]
+	b := NewBatch(3, time.Second, r.flush, WithBatchClock(newFakeClock()))
+	for i := range 7 {
+		b.Submit(i)
+	}
+	if got, want := r.get(), [][]int{{0, 1, 2}, {3, 4, 5}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("batches = %v, want %v", got, want)
+	}
+	b.Close()
+	if got, want := r.get(), [][]int{{0, 1, 2}, {3, 4, 5}, {6}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("batches after Close = %v, want %v", got, want)
+	}
+}
+
+func TestBatchFlushesOnTimeout(t *testing.T) {
+	clock := newFakeClock()
+	var r recorder[string]
+	b := NewBatch(10, time.Second, r.flush, WithBatchClock(clock))
+	defer b.Close()
+
+	b.Submit("a")
+	clock.Advance(600 * time.Millisecond)
+	b.Submit("b") // The delay counts from the first item, not the last
+	clock.Advance(399 * time.Millisecond)
+	if got := r.get(); len(got) != 0 {
+		t.Fatalf("flushed %v before maxDelay", got)
+	}
+	clock.Advance(time.Millisecond)
+	if got, want := r.get(), [][]string{{"a", "b"}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("batches = %v, want %v", got, want)
+	}
+
+	clock.Advance(time.Hour)
+	if got := r.get(); len(got) != 1 {
+		t.Errorf("an empty batch was flushed: %v", got)
+	}
+}
+
+// TestBatchStaleTimer checks that the timer of a batch that was flushed
+// because it was full does not flush the next batch early.
+func TestBatchStaleTimer(t *testing.T) {
+	clock := newFakeClock()
+	var r recorder[int]
+	b := NewBatch(2, time.Second, r.flush, WithBatchClock(clock))
+	defer b.Close()
+
+	b.Submit(1)
+	clock.Advance(900 * time.Millisecond)
+	b.Submit(2) // Full, flushes [1 2]
+	b.Submit(3)
+	clock.Advance(100 * time.Millisecond)
+	if got, want := r.get(), [][]int{{1, 2}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("batches = %v, want %v", got, want)
+	}
+	clock.Advance(900 * time.Millisecond)
+	if got, want := r.get(), [][]int{{1, 2}, {3}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("batches = %v, want %v", got, want)
+	}
+}
+
+func TestBatchCloseStopsTimer(t *testing.T) {
+	clock := newFakeClock()
+	var r recorder[int]
+	b := NewBatch(5, time.Second, r.flush, WithBatchClock(clock))
+	b.Submit(1)
+	b.Close()
+	b.Close()
+	clock.mu.Lock()
+	pending := len(clock.timers)
+	clock.mu.Unlock()
+	if pending != 0 {
+		t.Errorf("%d timers pending after Close, want 0", pending)
+	}
+	if got, want := r.get(), [][]int{{1}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("batches = %v, want %v", got, want)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("Submit after Close did not panic")
+		}
+	}()
+	b.Submit(2)
+}
+
+func TestBatchWithoutDelay(t *testing.T) {
+	clock := newFakeClock()
+	var r recorder[int]
+	b := NewBatch(3, 0, r.flush, WithBatchClock(clock))
+	b.Submit(1)
+	if got := clock.recordedDelays(); len(got) != 0 {
+		t.Errorf("scheduled timers %v without maxDelay", got)
+	}
+	b.Close()
+	if got, want := r.get(), [][]int{{1}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("batches = %v, want %v", got, want)
+	}
+}
+
+// TestBatchNoConcurrentFlush submits from many goroutines on the real
+// clock with a short delay, so that size and timeout flushes race, and
+// checks that flush never runs twice at once and no item is lost.
+func TestBatchNoConcurrentFlush(t *testing.T) {
+	var running, total atomic.Int64
+	b := NewBatch(7, time.Microsecond, func(items []int) {
+		if running.Add(1) != 1 {
+			t.Error("flush called concurrently")
+		}
+		time.Sleep(10 * time.Microsecond)
+		total.Add(int64(len(items)))
+		running.Add(-1)
+	})
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 100 {
+				b.Submit(i)
+			}
+		}()
+	}
+	wg.Wait()
+	b.Close()
+	if got := total.Load(); got != 800 {
+		t.Errorf("flushed %d items, want 800", got)
+	}
+}
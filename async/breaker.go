@@ -0,0 +1,168 @@
+package async
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned by CircuitBreaker.Call while the circuit is open.
+var ErrCircuitOpen = errors.New("async: circuit open")
+
+// Clock tells the time. It lets callers replace the wall clock, e.g. with a
+// fake clock in tests.
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time { return time.Now() }
+
+// CircuitState is the state of a CircuitBreaker.
+type CircuitState int
+
+const (
+	// Closed lets all calls through.
+	Closed CircuitState = iota
+	// Open rejects all calls with ErrCircuitOpen.
+	Open
+	// HalfOpen lets a single trial call through to probe whether the
+	// wrapped function has recovered.
+	HalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case Closed:
+		return "closed"
+	case Open:
+		return "open"
+	case HalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+// BreakerOption configures a CircuitBreaker.
+type BreakerOption func(*breakerOptions)
+
+type breakerOptions struct {
+	clock Clock
+}
+
+// WithClock makes the circuit breaker read the time from c instead of the
+// wall clock.
+func WithClock(c Clock) BreakerOption {
+	return func(o *breakerOptions) {
+		o.clock = c
+	}
+}
+
+// CircuitBreaker wraps a function and stops calling it after maxFailures
+// consecutive failures. Once resetTimeout has passed, a single trial call
+// decides whether the circuit closes again or stays open. It is safe for
+// concurrent use.
+type CircuitBreaker[T any] struct {
+	mu           sync.Mutex
+	f            func() (T, error)
+	clock        Clock
+	maxFailures  int
+	resetTimeout time.Duration
+
+	state    CircuitState
+	gen      uint64 // Incremented on every state change
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+// NewCircuitBreaker returns a closed circuit breaker around f.
+func NewCircuitBreaker[T any](maxFailures int, resetTimeout time.Duration, f func() (T, error), opts ...BreakerOption) *CircuitBreaker[T] {
+	o := breakerOptions{clock: systemClock{}}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &CircuitBreaker[T]{
+		f:            f,
+		clock:        o.clock,
+		maxFailures:  maxFailures,
+		resetTimeout: resetTimeout,
+	}
+}
+
+// State returns the current state of the circuit.
+func (cb *CircuitBreaker[T]) State() CircuitState {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	return cb.currentState()
+}
+
+// currentState moves an open circuit to half-open once the reset timeout
+// has passed. cb.mu must be held.
+func (cb *CircuitBreaker[T]) currentState() CircuitState {
+	if cb.state == Open && cb.clock.Now().Sub(cb.openedAt) >= cb.resetTimeout {
+		cb.setState(HalfOpen)
+	}
+	return cb.state
+}
+
+// setState changes the state and starts a new generation. cb.mu must be
+// held.
+func (cb *CircuitBreaker[T]) setState(state CircuitState) {
+	cb.state = state
+	cb.gen++
+	cb.failures = 0
+	if state == Open {
+		cb.openedAt = cb.clock.Now()
+	}
+}
+
+// Call calls the wrapped function unless the circuit is open. While the
+// circuit is half-open, only one caller gets through and all others receive
+// ErrCircuitOpen until the trial call has finished. Results of calls that
+// started before the last state change are ignored, and a panic in the
+// wrapped function counts as a failure before it is propagated.
+func (cb *CircuitBreaker[T]) Call() (v T, err error) {
+	cb.mu.Lock()
+	state := cb.currentState()
+	switch state {
+	case Open:
+		cb.mu.Unlock()
+		return v, ErrCircuitOpen
+	case HalfOpen:
+		if cb.probing {
+			cb.mu.Unlock()
+			return v, ErrCircuitOpen
+		}
+		cb.probing = true
+	}
+	gen := cb.gen
+	cb.mu.Unlock()
+
+	completed := false
+	defer func() {
+		cb.mu.Lock()
+		defer cb.mu.Unlock()
+		if state == HalfOpen {
+			cb.probing = false
+		}
+		if cb.gen != gen {
+			return
+		}
+		if completed && err == nil {
+			if cb.state != Closed {
+				cb.setState(Closed)
+			}
+			cb.failures = 0
+			return
+		}
+		cb.failures++
+		if cb.state == HalfOpen || cb.failures >= cb.maxFailures {
+			cb.setState(Open)
+		}
+	}()
+	v, err = cb.f()
+	completed = true
+	return v, err
+}
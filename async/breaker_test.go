@@ -0,0 +1,227 @@
+package async
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+var errFailed = errors.New("failed")
+
+// switchable is a wrapped function whose result the test controls.
+type switchable struct {
+	mu    sync.Mutex
+	err   error
+	calls int
+}
+
+func (s *switchable) call() (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls++
+	return s.calls, s.err
+}
+
+func (s *switchable) set(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
+
+func TestCircuitBreakerOpens(t *testing.T) {
+	clock := newFakeClock()
+	f := &switchable{err: errFailed}
+	cb := NewCircuitBreaker(3, time.Minute, f.call, WithClock(clock))
+	for i := range 3 {
+		if cb.State() != Closed {
+			t.Fatalf("state after %d failures = %v, want closed", i, cb.State())
+		}
+		if _, err := cb.Call(); err != errFailed {
+			t.Fatalf("Call() error = %v, want %v", err, errFailed)
+		}
+	}
+	if cb.State() != Open {
+		t.Fatalf("state after 3 failures = %v, want open", cb.State())
+	}
+	if _, err := cb.Call(); err != ErrCircuitOpen {
+		t.Errorf("Call() on an open circuit error = %v, want %v", err, ErrCircuitOpen)
+	}
+	if f.calls != 3 {
+		t.Errorf("f called %d times, want 3", f.calls)
+	}
+}
+
+func TestCircuitBreakerSuccessResetsFailures(t *testing.T) {
+	f := &switchable{err: errFailed}
+	cb := NewCircuitBreaker(2, time.Minute, f.call, WithClock(newFakeClock()))
+	cb.Call()
+	f.set(nil)
+	cb.Call()
+	f.set(errFailed)
+	cb.Call()
+	if cb.State() != Closed {
+		t.Errorf("state = %v, want closed, failures are only counted in a row", cb.State())
+	}
+}
+
+func TestCircuitBreakerHalfOpen(t *testing.T) {
+	clock := newFakeClock()
+	f := &switchable{err: errFailed}
+	cb := NewCircuitBreaker(1, time.Minute, f.call, WithClock(clock))
+	cb.Call()
+
+	clock.Advance(59 * time.Second)
+	if cb.State() != Open {
+		t.Fatalf("state before the reset timeout = %v, want open", cb.State())
+	}
+	clock.Advance(time.Second)
+	if cb.State() != HalfOpen {
+		t.Fatalf("state after the reset timeout = %v, want half-open", cb.State())
+	}
+	// A failed probe opens the circuit again for another resetTimeout.
+	if _, err := cb.Call(); err != errFailed {
+		t.Fatalf("probe error = %v, want %v", err, errFailed)
+	}
+	if cb.State() != Open {
+		t.Fatalf("state after a failed probe = %v, want open", cb.State())
+	}
+	clock.Advance(time.Minute)
+	f.set(nil)
+	if v, err := cb.Call(); err != nil || v != 3 {
+		t.Fatalf("probe = %d, %v, want 3, nil", v, err)
+	}
+	if cb.State() != Closed {
+		t.Errorf("state after a successful probe = %v, want closed", cb.State())
+	}
+}
+
+func TestCircuitBreakerSingleProbe(t *testing.T) {
+	clock := newFakeClock()
+	release := make(chan struct{})
+	started := make(chan struct{})
+	fail := true
+	cb := NewCircuitBreaker(1, time.Minute, func() (int, error) {
+		if fail {
+			return 0, errFailed
+		}
+		close(started)
+		<-release
+		return 1, nil
+	}, WithClock(clock))
+	cb.Call()
+	fail = false
+	clock.Advance(time.Minute)
+
+	done := make(chan error)
+	go func() {
+		_, err := cb.Call()
+		done <- err
+	}()
+	<-started
+	if _, err := cb.Call(); err != ErrCircuitOpen {
+		t.Errorf("second call during the probe error = %v, want %v", err, ErrCircuitOpen)
+	}
+	close(release)
+	if err := <-done; err != nil {
+		t.Errorf("probe error = %v, want nil", err)
+	}
+	if cb.State() != Closed {
+		t.Errorf("state = %v, want closed", cb.State())
+	}
+}
+
+func TestCircuitBreakerProbePanics(t *testing.T) {
+	clock := newFakeClock()
+	panics := false
+	cb := NewCircuitBreaker(1, time.Minute, func() (int, error) {
+		if panics {
+			panic("boom")
+		}
+		return 0, errFailed
+	}, WithClock(clock))
+	cb.Call()
+	clock.Advance(time.Minute)
+	panics = true
+	func() {
+		defer func() {
+			if recover() != "boom" {
+				t.Error("panic of the wrapped function was not propagated")
+			}
+		}()
+		cb.Call()
+	}()
+	if cb.State() != Open {
+		t.Fatalf("state after a panicking probe = %v, want open", cb.State())
+	}
+	clock.Advance(time.Minute)
+	panics = false
+	// The panic must not leave the probe marked as running.
+	if _, err := cb.Call(); err != errFailed {
+		t.Errorf("next probe error = %v, want %v", err, errFailed)
+	}
+}
+
+func TestCircuitBreakerIgnoresStaleResults(t *testing.T) {
+	clock := newFakeClock()
+	release := make(chan struct{})
+	started := make(chan struct{})
+	var slow bool
+	cb := NewCircuitBreaker(1, time.Minute, func() (int, error) {
+		if slow {
+			close(started)
+			<-release
+			return 1, nil
+		}
+		return 0, errFailed
+	}, WithClock(clock))
+
+	slow = true
+	done := make(chan struct{})
+	go func() {
+		cb.Call()
+		close(done)
+	}()
+	<-started
+	slow = false
+	cb.Call() // Opens the circuit while the slow call is running
+	close(release)
+	<-done
+	if cb.State() != Open {
+		t.Errorf("state = %v, want open, the success started before the circuit opened", cb.State())
+	}
+}
+
+func TestCircuitBreakerConcurrent(t *testing.T) {
+	clock := newFakeClock()
+	f := &switchable{}
+	cb := NewCircuitBreaker(5, time.Minute, f.call, WithClock(clock))
+	var wg sync.WaitGroup
+	for g := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 100 {
+				if (g+i)%10 == 0 {
+					f.set(errFailed)
+				} else if (g+i)%10 == 5 {
+					f.set(nil)
+				}
+				cb.Call()
+				cb.State()
+				if i%25 == 0 {
+					clock.Advance(time.Minute)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestCircuitStateString(t *testing.T) {
+	for state, want := range map[CircuitState]string{Closed: "closed", Open: "open", HalfOpen: "half-open", 7: "unknown"} {
+		if got := state.String(); got != want {
+			t.Errorf("CircuitState(%d).String() = %q, want %q", state, got, want)
+		}
+	}
+}
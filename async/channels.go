@@ -0,0 +1,114 @@
+package async
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// send sends v on ch unless ctx is done first. It reports whether v was
+// sent.
+func send[T any](ctx context.Context, ch chan<- T, v T) bool {
+	select {
+	case ch <- v:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func makeOutputs[T any](n int) ([]chan T, []<-chan T) {
+	if n <= 0 {
+		panic(fmt.Sprintf("async: invalid number of outputs %d", n))
+	}
+	outs := make([]chan T, n)
+	recv := make([]<-chan T, n)
+	for i := range outs {
+		outs[i] = make(chan T)
+		recv[i] = outs[i]
+	}
+	return outs, recv
+}
+
+// distribute reads from in until it is closed or ctx is done, passes every
+// value to deliver and closes outs when it returns.
+func distribute[T any](ctx context.Context, in <-chan T, outs []chan T, deliver func(T) bool) {
+	defer func() {
+		for _, out := range outs {
+			close(out)
+		}
+	}()
+	for {
+		select {
+		case v, ok := <-in:
+			if !ok || !deliver(v) {
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// FanOut distributes the values from in round-robin over n channels, so
+// every value is received exactly once. A slow reader holds up the others.
+// All outputs are closed once in is closed or ctx is done. FanOut panics if
+// n is not positive.
+func FanOut[T any](ctx context.Context, in <-chan T, n int) []<-chan T {
+	outs, recv := makeOutputs[T](n)
+	go func() {
+		next := 0
+		distribute(ctx, in, outs, func(v T) bool {
+			ok := send(ctx, outs[next], v)
+			next = (next + 1) % n
+			return ok
+		})
+	}()
+	return recv
+}
+
+// Broadcast sends every value from in to all of n channels, one after the
+// other, so the next value is only read once every output has received the
+// current one. All outputs are closed once in is closed or ctx is done.
+// Broadcast panics if n is not positive.
+func Broadcast[T any](ctx context.Context, in <-chan T, n int) []<-chan T {
+	outs, recv := makeOutputs[T](n)
+	go distribute(ctx, in, outs, func(v T) bool {
+		for _, out := range outs {
+			if !send(ctx, out, v) {
+				return false
+			}
+		}
+		return true
+	})
+	return recv
+}
+
+// FanIn merges chans into a single channel, which is closed once all of
+// chans are closed or ctx is done. The order of values from different
+// inputs is unspecified.
+func FanIn[T any](ctx context.Context, chans ...<-chan T) <-chan T {
+	out := make(chan T)
+	var wg sync.WaitGroup
+	wg.Add(len(chans))
+	for _, ch := range chans {
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case v, ok := <-ch:
+					if !ok || !send(ctx, out, v) {
+						return
+					}
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
@@ -0,0 +1,116 @@
+package async
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+)
+
+// generate returns a channel that yields values and is then closed.
+func generate[T any](values ...T) <-chan T {
+	ch := make(chan T)
+	go func() {
+		defer close(ch)
+		for _, v := range values {
+			ch <- v
+		}
+	}()
+	return ch
+}
+
+// drainAll reads every channel to the end concurrently and returns what
+// each one delivered.
+func drainAll[T any](chans []<-chan T) [][]T {
+	got := make([][]T, len(chans))
+	var wg sync.WaitGroup
+	for i, ch := range chans {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for v := range ch {
+				got[i] = append(got[i], v)
+			}
+		}()
+	}
+	wg.Wait()
+	return got
+}
+
+func TestFanOut(t *testing.T) {
+	verifyNoLeaks(t)
+	outs := FanOut(context.Background(), generate(1, 2, 3, 4, 5, 6, 7), 3)
+	got := drainAll(outs)
+	want := [][]int{{1, 4, 7}, {2, 5}, {3, 6}}
+	for i := range want {
+		if !slices.Equal(got[i], want[i]) {
+			t.Errorf("output %d received %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestBroadcast(t *testing.T) {
+	verifyNoLeaks(t)
+	outs := Broadcast(context.Background(), generate("a", "b"), 3)
+	for i, values := range drainAll(outs) {
+		if !slices.Equal(values, []string{"a", "b"}) {
+			t.Errorf("output %d received %v, want [a b]", i, values)
+		}
+	}
+}
+
+func TestFanIn(t *testing.T) {
+	verifyNoLeaks(t)
+	var got []int
+	for v := range FanIn(context.Background(), generate(1, 2), generate(3), generate[int]()) {
+		got = append(got, v)
+	}
+	slices.Sort(got)
+	if !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("FanIn delivered %v, want [1 2 3]", got)
+	}
+	if _, ok := <-FanIn[int](context.Background()); ok {
+		t.Error("FanIn without inputs delivered a value")
+	}
+}
+
+// TestCancel checks that cancelling the context closes every output and
+// stops all goroutines, even though the input is never closed and nobody
+// reads the outputs.
+func TestCancel(t *testing.T) {
+	verifyNoLeaks(t)
+	in := make(chan int)
+	ctx, cancel := context.WithCancel(context.Background())
+	fanOut := FanOut(ctx, in, 2)
+	broadcast := Broadcast(ctx, in, 2)
+	fanIn := FanIn(ctx, in)
+	cancel()
+	for _, ch := range append(append(fanOut, broadcast...), fanIn) {
+		for range ch {
+		}
+	}
+}
+
+// TestCancelWhileSending cancels while a value is pending on an output that
+// is not being read.
+func TestCancelWhileSending(t *testing.T) {
+	verifyNoLeaks(t)
+	in := make(chan int, 1)
+	in <- 1
+	ctx, cancel := context.WithCancel(context.Background())
+	outs := Broadcast(ctx, in, 2)
+	if v := <-outs[0]; v != 1 {
+		t.Errorf("first output received %d, want 1", v)
+	}
+	cancel() // The second output is still waiting to be read
+	drainAll(outs)
+}
+
+func TestInvalidOutputs(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("FanOut with 0 outputs did not panic")
+		}
+	}()
+	FanOut(context.Background(), generate(1), 0)
+}
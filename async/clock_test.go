@@ -0,0 +1,104 @@
+package async
+
+import (
+	"sync"
+	"time"
+)
+
+// fakeClock is a TimerClock whose time only moves on Advance.
+type fakeClock struct {
+	mu     sync.Mutex
+	cond   *sync.Cond // signalled when a timer is scheduled
+	now    time.Time
+	timers []*fakeTimer
+	delays []time.Duration // every delay passed to AfterFunc
+	// instant makes AfterFunc advance the time by d and call f right away.
+	instant bool
+}
+
+type fakeTimer struct {
+	at time.Time
+	f  func()
+}
+
+func newFakeClock() *fakeClock {
+	c := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	c.cond = sync.NewCond(&c.mu)
+	return c
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) AfterFunc(d time.Duration, f func()) func() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.delays = append(c.delays, d)
+	if c.instant {
+		c.now = c.now.Add(d)
+		go f()
+		return func() bool { return false }
+	}
+	t := &fakeTimer{at: c.now.Add(d), f: f}
+	c.timers = append(c.timers, t)
+	c.cond.Broadcast()
+	return func() bool {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		for i, pending := range c.timers {
+			if pending == t {
+				c.timers = append(c.timers[:i], c.timers[i+1:]...)
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// waitTimers blocks until at least n timers are pending.
+func (c *fakeClock) waitTimers(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for len(c.timers) < n {
+		c.cond.Wait()
+	}
+}
+
+// Advance moves the time forward by d and runs the timers that are due, in
+// the order of their deadlines.
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	c.now = c.now.Add(d)
+	var due []*fakeTimer
+	pending := c.timers[:0]
+	for _, t := range c.timers {
+		if t.at.After(c.now) {
+			pending = append(pending, t)
+		} else {
+			due = append(due, t)
+		}
+	}
+	c.timers = pending
+	c.mu.Unlock()
+
+	for len(due) > 0 {
+		next := 0
+		for i, t := range due {
+			if t.at.Before(due[next].at) {
+				next = i
+			}
+		}
+		due[next].f()
+		due = append(due[:next], due[next+1:]...)
+	}
+}
+
+// recordedDelays returns the delays passed to AfterFunc so far.
+func (c *fakeClock) recordedDelays() []time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]time.Duration(nil), c.delays...)
+}
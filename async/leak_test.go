@@ -0,0 +1,26 @@
+package async
+
+import (
+	"runtime"
+	"testing"
+	"time"
+)
+
+// verifyNoLeaks fails the test if it ends with more goroutines than it
+// started with. It stands in for go.uber.org/goleak, which this module
+// does not depend on.
+func verifyNoLeaks(t *testing.T) {
+	t.Helper()
+	before := runtime.NumGoroutine()
+	t.Cleanup(func() {
+		deadline := time.Now().Add(time.Second)
+		for runtime.NumGoroutine() > before {
+			if time.Now().After(deadline) {
+				buf := make([]byte, 1<<16)
+				t.Errorf("%d goroutines leaked:\n%s", runtime.NumGoroutine()-before, buf[:runtime.Stack(buf, true)])
+				return
+			}
+			time.Sleep(time.Millisecond)
+		}
+	})
+}
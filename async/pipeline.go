@@ -0,0 +1,140 @@
+package async
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Pipeline runs values through a fixed sequence of stages.
+type Pipeline[T any] struct {
+	stages []func(T) (T, error)
+}
+
+// NewPipeline returns a pipeline that runs stages in order.
+func NewPipeline[T any](stages ...func(T) (T, error)) *Pipeline[T] {
+	return &Pipeline[T]{stages: stages}
+}
+
+// Run passes v through all stages and stops at the first error.
+func (p *Pipeline[T]) Run(v T) (T, error) {
+	for _, stage := range p.stages {
+		var err error
+		if v, err = stage(v); err != nil {
+			return v, err
+		}
+	}
+	return v, nil
+}
+
+// RunAll runs every item through the pipeline on its own. It returns the
+// results of the items that passed all stages, in input order, and one
+// error per failed item, naming its index.
+func (p *Pipeline[T]) RunAll(items []T) ([]T, []error) {
+	var results []T
+	var errs []error
+	for i, item := range items {
+		v, err := p.Run(item)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("item %d: %w", i, err))
+			continue
+		}
+		results = append(results, v)
+	}
+	return results, errs
+}
+
+// ParallelPipeline is a Pipeline whose RunAll processes several items at
+// once. Each item still passes the stages in order.
+type ParallelPipeline[T any] struct {
+	Pipeline[T]
+	concurrency int
+}
+
+// NewParallelPipeline returns a pipeline whose RunAll uses up to
+// concurrency goroutines. It panics if concurrency is not positive.
+func NewParallelPipeline[T any](concurrency int, stages ...func(T) (T, error)) *ParallelPipeline[T] {
+	if concurrency <= 0 {
+		panic(fmt.Sprintf("async: invalid concurrency %d", concurrency))
+	}
+	return &ParallelPipeline[T]{Pipeline: Pipeline[T]{stages: stages}, concurrency: concurrency}
+}
+
+// RunAll is like Pipeline.RunAll but runs items concurrently. Results and
+// errors are still reported in input order.
+func (p *ParallelPipeline[T]) RunAll(items []T) ([]T, []error) {
+	values := make([]T, len(items))
+	itemErrs := make([]error, len(items))
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for range min(p.concurrency, len(items)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				values[i], itemErrs[i] = p.Run(items[i])
+			}
+		}()
+	}
+	for i := range items {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+
+	var results []T
+	var errs []error
+	for i, err := range itemErrs {
+		if err != nil {
+			errs = append(errs, fmt.Errorf("item %d: %w", i, err))
+			continue
+		}
+		results = append(results, values[i])
+	}
+	return results, errs
+}
+
+// Pipeline2 transforms an A into a B, possibly through stages of other
+// types. The zero value is not usable; build pipelines with Stage and Chain.
+type Pipeline2[A, B any] struct {
+	run func(A) (B, error)
+}
+
+// Stage lifts f into a single-stage pipeline.
+func Stage[A, B any](f func(A) (B, error)) Pipeline2[A, B] {
+	return Pipeline2[A, B]{run: f}
+}
+
+// Chain returns a pipeline that runs ab and then bc. The result of ab is
+// passed to bc only if ab succeeded.
+func Chain[A, B, C any](ab Pipeline2[A, B], bc Pipeline2[B, C]) Pipeline2[A, C] {
+	return Pipeline2[A, C]{run: func(a A) (C, error) {
+		b, err := ab.run(a)
+		if err != nil {
+			var zero C
+			return zero, err
+		}
+		return bc.run(b)
+	}}
+}
+
+// Run passes v through all stages and stops at the first error.
+func (p Pipeline2[A, B]) Run(v A) (B, error) {
+	return p.run(v)
+}
+
+// RunAll2 runs every input through p on its own, like Pipeline.RunAll, and
+// returns the results of the successful inputs in input order together
+// with one error per failed input.
+func RunAll2[A, B any](p Pipeline2[A, B], inputs []A) ([]B, []error) {
+	var results []B
+	var errs []error
+	for i, input := range inputs {
+		v, err := p.run(input)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("item %d: %w", i, err))
+			continue
+		}
+		results = append(results, v)
+	}
+	return results, errs
+}
@@ -0,0 +1,136 @@
+// Package async provides generic building blocks for concurrent code.
+package async
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrNoPromises is the error of a Race over no promises.
+var ErrNoPromises = errors.New("async: no promises")
+
+// Promise is the result of a function that runs in its own goroutine.
+type Promise[T any] struct {
+	done   chan struct{}
+	cancel context.CancelFunc
+	value  T
+	err    error
+}
+
+// NewPromise runs f in a new goroutine and returns a Promise for its result.
+func NewPromise[T any](f func() (T, error)) *Promise[T] {
+	return NewPromiseCtx(context.Background(), func(context.Context) (T, error) { return f() })
+}
+
+// NewPromiseCtx is like NewPromise but passes f a context derived from ctx
+// that is cancelled by Cancel, or once f has returned.
+func NewPromiseCtx[T any](ctx context.Context, f func(context.Context) (T, error)) *Promise[T] {
+	ctx, cancel := context.WithCancel(ctx)
+	p := &Promise[T]{done: make(chan struct{}), cancel: cancel}
+	go func() {
+		defer close(p.done)
+		defer cancel()
+		p.value, p.err = f(ctx)
+	}()
+	return p
+}
+
+// Cancel cancels the context of the function behind p. It only has an
+// effect if the function was started with NewPromiseCtx and watches its
+// context.
+func (p *Promise[T]) Cancel() {
+	p.cancel()
+}
+
+// Done returns a channel that is closed once the result is available.
+func (p *Promise[T]) Done() <-chan struct{} {
+	return p.done
+}
+
+// Await blocks until the result is available and returns it.
+func (p *Promise[T]) Await() (T, error) {
+	<-p.done
+	return p.value, p.err
+}
+
+// Then returns a Promise for f applied to the value of p. If p fails, f is
+// not called and the error is passed on.
+func Then[T, U any](p *Promise[T], f func(T) U) *Promise[U] {
+	return NewPromise(func() (U, error) {
+		v, err := p.Await()
+		if err != nil {
+			var zero U
+			return zero, err
+		}
+		return f(v), nil
+	})
+}
+
+type indexed[T any] struct {
+	i     int
+	value T
+	err   error
+}
+
+// collect waits for all promises in separate goroutines. The channel is
+// buffered, so no goroutine leaks if the caller stops reading early.
+func collect[T any](promises []*Promise[T]) <-chan indexed[T] {
+	results := make(chan indexed[T], len(promises))
+	for i, p := range promises {
+		go func() {
+			v, err := p.Await()
+			results <- indexed[T]{i: i, value: v, err: err}
+		}()
+	}
+	return results
+}
+
+func cancelAll[T any](promises []*Promise[T]) {
+	for _, p := range promises {
+		p.Cancel()
+	}
+}
+
+// All returns a Promise for the values of all promises in the same order.
+// It fails as soon as one of them fails and cancels the others, see
+// NewPromiseCtx.
+func All[T any](promises ...*Promise[T]) *Promise[[]T] {
+	return NewPromise(func() ([]T, error) {
+		values := make([]T, len(promises))
+		results := collect(promises)
+		for range promises {
+			r := <-results
+			if r.err != nil {
+				cancelAll(promises)
+				return nil, r.err
+			}
+			values[r.i] = r.value
+		}
+		return values, nil
+	})
+}
+
+// Race returns a Promise for the value of the first promise that succeeds
+// and cancels the others. If all promises fail, it fails with all of their
+// errors joined, and without promises it fails with ErrNoPromises.
+func Race[T any](promises ...*Promise[T]) *Promise[T] {
+	return NewPromise(func() (T, error) {
+		if len(promises) == 0 {
+			var zero T
+			return zero, ErrNoPromises
+		}
+		errs := make([]error, len(promises))
+		results := collect(promises)
+		for range promises {
+			r := <-results
+			if r.err == nil {
+				cancelAll(promises)
+				return r.value, nil
+			}
+			errs[r.i] = r.err
+		}
+		var zero T
+		return zero, fmt.Errorf("async: no promise succeeded: %w", errors.Join(errs...))
+	})
+}
@@ -0,0 +1,99 @@
+package async
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestPromiseAwait(t *testing.T) {
+	verifyNoLeaks(t)
+	p := NewPromise(func() (int, error) { return 42, nil })
+	if v, err := p.Await(); v != 42 || err != nil {
+		t.Errorf("Await() = %v, %v, want 42, nil", v, err)
+	}
+	<-p.Done()
+}
+
+func TestThen(t *testing.T) {
+	verifyNoLeaks(t)
+	p := Then(NewPromise(func() (int, error) { return 2, nil }), func(v int) string {
+		return string(rune('a' + v))
+	})
+	if v, err := p.Await(); v != "c" || err != nil {
+		t.Errorf("Await() = %q, %v, want \"c\", nil", v, err)
+	}
+
+	errBoom := errors.New("boom")
+	failed := Then(NewPromise(func() (int, error) { return 0, errBoom }), func(int) int {
+		t.Error("f called for a failed promise")
+		return 0
+	})
+	if _, err := failed.Await(); !errors.Is(err, errBoom) {
+		t.Errorf("err = %v, want %v", err, errBoom)
+	}
+}
+
+func TestAll(t *testing.T) {
+	verifyNoLeaks(t)
+	p := All(
+		NewPromise(func() (int, error) { time.Sleep(10 * time.Millisecond); return 1, nil }),
+		NewPromise(func() (int, error) { return 2, nil }),
+	)
+	if v, err := p.Await(); !reflect.DeepEqual(v, []int{1, 2}) || err != nil {
+		t.Errorf("Await() = %v, %v, want [1 2], nil", v, err)
+	}
+}
+
+func TestAllCancelsOnFailure(t *testing.T) {
+	verifyNoLeaks(t)
+	errBoom := errors.New("boom")
+	slow := NewPromiseCtx(context.Background(), func(ctx context.Context) (int, error) {
+		<-ctx.Done()
+		return 0, ctx.Err()
+	})
+	p := All(slow, NewPromise(func() (int, error) { return 0, errBoom }))
+	if _, err := p.Await(); !errors.Is(err, errBoom) {
+		t.Errorf("err = %v, want %v", err, errBoom)
+	}
+	if _, err := slow.Await(); !errors.Is(err, context.Canceled) {
+		t.Errorf("remaining promise err = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestRace(t *testing.T) {
+	verifyNoLeaks(t)
+	loser := NewPromiseCtx(context.Background(), func(ctx context.Context) (string, error) {
+		<-ctx.Done()
+		return "slow", ctx.Err()
+	})
+	p := Race(loser, NewPromise(func() (string, error) { return "fast", nil }))
+	if v, err := p.Await(); v != "fast" || err != nil {
+		t.Errorf("Await() = %q, %v, want \"fast\", nil", v, err)
+	}
+	if _, err := loser.Await(); !errors.Is(err, context.Canceled) {
+		t.Errorf("loser err = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestRaceAllFail(t *testing.T) {
+	verifyNoLeaks(t)
+	errA, errB := errors.New("a"), errors.New("b")
+	p := Race(
+		NewPromise(func() (int, error) { return 0, errA }),
+		NewPromise(func() (int, error) { return 0, errB }),
+	)
+	_, err := p.Await()
+	if !errors.Is(err, errA) || !errors.Is(err, errB) {
+		t.Errorf("err = %v, want both %v and %v", err, errA, errB)
+	}
+}
+
+func TestRaceEmpty(t *testing.T) {
+	verifyNoLeaks(t)
+	if _, err := Race[int]().Await(); !errors.Is(err, ErrNoPromises) {
+		t.Errorf("err = %v, want %v", err, ErrNoPromises)
+	}
+}
@@ -0,0 +1,105 @@
+package async
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+)
+
+// Backoff yields the delays between retries. Backoffs are stateful, so use
+// a fresh one for every call to Retry.
+type Backoff interface {
+	Next() time.Duration
+}
+
+type constantBackoff time.Duration
+
+func (b constantBackoff) Next() time.Duration {
+	return time.Duration(b)
+}
+
+// ConstantBackoff waits d between all attempts.
+func ConstantBackoff(d time.Duration) Backoff {
+	return constantBackoff(d)
+}
+
+type exponentialBackoff struct {
+	next, max time.Duration
+	factor    float64
+}
+
+func (b *exponentialBackoff) Next() time.Duration {
+	d := b.next
+	// Clamp before converting, a float64 beyond the range of Duration would
+	// wrap around to a negative delay.
+	if next := float64(b.next) * b.factor; next < float64(b.max) {
+		b.next = time.Duration(next)
+	} else {
+		b.next = b.max
+	}
+	return d
+}
+
+// ExponentialBackoff starts with initial and multiplies the delay by factor
+// after every attempt, never exceeding max.
+func ExponentialBackoff(initial, max time.Duration, factor float64) Backoff {
+	return &exponentialBackoff{next: min(initial, max), max: max, factor: factor}
+}
+
+type jitteredBackoff struct {
+	b      Backoff
+	jitter float64
+}
+
+func (b jitteredBackoff) Next() time.Duration {
+	d := float64(b.b.Next())
+	return time.Duration(d + d*b.jitter*(2*rand.Float64()-1))
+}
+
+// JitteredBackoff randomizes the delays of b by up to ±jitter, e.g. 0.1 for
+// ±10%, so that many clients do not retry in lockstep.
+func JitteredBackoff(b Backoff, jitter float64) Backoff {
+	return jitteredBackoff{b: b, jitter: jitter}
+}
+
+// Retry calls f until it succeeds, at most attempts times, and waits for
+// backoff.Next() between attempts. If ctx is cancelled, Retry returns
+// ctx.Err() immediately. If all attempts fail, the errors of all attempts
+// are joined. Retry panics if attempts is not positive.
+func Retry[T any](ctx context.Context, attempts int, backoff Backoff, f func() (T, error)) (T, error) {
+	return retry(ctx, systemClock{}, attempts, backoff, f)
+}
+
+// retry is Retry on the given clock, which lets tests run on fake time.
+func retry[T any](ctx context.Context, clock TimerClock, attempts int, backoff Backoff, f func() (T, error)) (T, error) {
+	if attempts <= 0 {
+		panic(fmt.Sprintf("async: invalid number of attempts %d", attempts))
+	}
+	var zero T
+	var errs []error
+	for attempt := range attempts {
+		if err := ctx.Err(); err != nil {
+			return zero, err
+		}
+		v, err := f()
+		if err == nil {
+			return v, nil
+		}
+		errs = append(errs, err)
+		if attempt == attempts-1 {
+			break
+		}
+
+		wake := make(chan struct{})
+		stop := clock.AfterFunc(backoff.Next(), func() { close(wake) })
+		select {
+		case <-ctx.Done():
+			stop()
+			return zero, ctx.Err()
+		case <-wake:
+		}
+	}
+	return zero, errors.Join(errs...)
+}
@@ -0,0 +1,137 @@
+package async
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"testing"
+	"time"
+)
+
+// failing returns a function that fails n times and then returns "ok".
+func failing(n int) (f func() (string, error), calls *int) {
+	calls = new(int)
+	return func() (string, error) {
+		*calls++
+		if *calls <= n {
+			return "", fmt.Errorf("attempt %d failed", *calls)
+		}
+		return "ok", nil
+	}, calls
+}
+
+func TestRetrySucceeds(t *testing.T) {
+	clock := newFakeClock()
+	clock.instant = true
+	f, calls := failing(2)
+	v, err := retry(context.Background(), clock, 5, ConstantBackoff(time.Second), f)
+	if v != "ok" || err != nil {
+		t.Errorf("Retry = %q, %v, want \"ok\", nil", v, err)
+	}
+	if *calls != 3 {
+		t.Errorf("f called %d times, want 3", *calls)
+	}
+	if got := clock.recordedDelays(); !slices.Equal(got, []time.Duration{time.Second, time.Second}) {
+		t.Errorf("delays = %v, want [1s 1s]", got)
+	}
+}
+
+func TestRetryJoinsErrors(t *testing.T) {
+	clock := newFakeClock()
+	clock.instant = true
+	f, calls := failing(10)
+	_, err := retry(context.Background(), clock, 3, ConstantBackoff(time.Second), f)
+	if *calls != 3 {
+		t.Errorf("f called %d times, want 3", *calls)
+	}
+	if want := "attempt 1 failed\nattempt 2 failed\nattempt 3 failed"; err == nil || err.Error() != want {
+		t.Errorf("error = %v, want %q", err, want)
+	}
+	if got := len(clock.recordedDelays()); got != 2 {
+		t.Errorf("waited %d times, want 2 (no wait after the last attempt)", got)
+	}
+}
+
+func TestRetryCancel(t *testing.T) {
+	clock := newFakeClock()
+	ctx, cancel := context.WithCancel(context.Background())
+	f, calls := failing(10)
+	done := make(chan error)
+	go func() {
+		_, err := retry(ctx, clock, 5, ConstantBackoff(time.Hour), f)
+		done <- err
+	}()
+	clock.waitTimers(1)
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("error = %v, want %v", err, context.Canceled)
+	}
+	if *calls != 1 {
+		t.Errorf("f called %d times, want 1", *calls)
+	}
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+	if len(clock.timers) != 0 {
+		t.Error("cancelled retry left its timer pending")
+	}
+}
+
+func TestRetryCancelledBeforeStart(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	f, calls := failing(0)
+	if _, err := Retry(ctx, 3, ConstantBackoff(0), f); !errors.Is(err, context.Canceled) {
+		t.Errorf("error = %v, want %v", err, context.Canceled)
+	}
+	if *calls != 0 {
+		t.Errorf("f called %d times, want 0", *calls)
+	}
+}
+
+func TestRetryInvalidAttempts(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Retry with 0 attempts did not panic")
+		}
+	}()
+	Retry(context.Background(), 0, ConstantBackoff(0), func() (int, error) { return 0, nil })
+}
+
+func TestRetryRealClock(t *testing.T) {
+	f, _ := failing(2)
+	if v, err := Retry(context.Background(), 3, ConstantBackoff(time.Millisecond), f); v != "ok" || err != nil {
+		t.Errorf("Retry = %q, %v, want \"ok\", nil", v, err)
+	}
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	b := ExponentialBackoff(time.Second, 10*time.Second, 2)
+	var got []time.Duration
+	for range 6 {
+		got = append(got, b.Next())
+	}
+	want := []time.Duration{1 * time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second}
+	if !slices.Equal(got, want) {
+		t.Errorf("delays = %v, want %v", got, want)
+	}
+}
+
+func TestExponentialBackoffNoOverflow(t *testing.T) {
+	b := ExponentialBackoff(time.Hour, math.MaxInt64, 1000)
+	for i := range 20 {
+		if d := b.Next(); d <= 0 {
+			t.Fatalf("delay %d = %v, want positive", i, d)
+		}
+	}
+}
+
+func TestJitteredBackoff(t *testing.T) {
+	b := JitteredBackoff(ConstantBackoff(time.Second), 0.1)
+	for range 100 {
+		if d := b.Next(); d < 900*time.Millisecond || d > 1100*time.Millisecond {
+			t.Fatalf("delay = %v, want within 10%% of 1s", d)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package async
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"projektarbeit-go-generics/collections"
+	"projektarbeit-go-generics/result"
+)
+
+// WorkerPoolOption configures a WorkerPool.
+type WorkerPoolOption func(*workerPoolOptions)
+
+type workerPoolOptions struct {
+	ctx context.Context
+}
+
+// WithContext makes the pool skip tasks that have not started yet once ctx
+// is done. Skipped tasks yield ctx.Err() as their result.
+func WithContext(ctx context.Context) WorkerPoolOption {
+	return func(o *workerPoolOptions) {
+		o.ctx = ctx
+	}
+}
+
+// WorkerPool runs fn on submitted tasks with a fixed number of goroutines
+// and streams one result per task. Results arrive in completion order and
+// must be received concurrently with Submit and Wait, otherwise the
+// workers stall.
+type WorkerPool[T, R any] struct {
+	fn      func(T) (R, error)
+	ctx     context.Context
+	results chan result.Result[R]
+	workers sync.WaitGroup
+
+	mu      sync.Mutex
+	cond    *sync.Cond // signalled when tasks are queued or the pool is closing
+	tasks   collections.Queue[T]
+	closing bool
+	once    sync.Once
+}
+
+// NewWorkerPool starts workers goroutines that call fn. It panics if
+// workers is not positive.
+func NewWorkerPool[T, R any](workers int, fn func(T) (R, error), opts ...WorkerPoolOption) *WorkerPool[T, R] {
+	if workers <= 0 {
+		panic(fmt.Sprintf("async: invalid number of workers %d", workers))
+	}
+	o := workerPoolOptions{ctx: context.Background()}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	p := &WorkerPool[T, R]{fn: fn, ctx: o.ctx, results: make(chan result.Result[R], workers)}
+	p.cond = sync.NewCond(&p.mu)
+	p.workers.Add(workers)
+	for range workers {
+		go p.work()
+	}
+	return p
+}
+
+func (p *WorkerPool[T, R]) work() {
+	defer p.workers.Done()
+	for {
+		p.mu.Lock()
+		for p.tasks.Len() == 0 && !p.closing {
+			p.cond.Wait()
+		}
+		task, ok := p.tasks.Dequeue()
+		p.mu.Unlock()
+		if !ok {
+			return // Closing and no tasks left
+		}
+
+		if err := p.ctx.Err(); err != nil {
+			p.results <- result.Err[R](err)
+			continue
+		}
+		v, err := p.fn(task)
+		if err != nil {
+			p.results <- result.Err[R](err)
+		} else {
+			p.results <- result.OK(v)
+		}
+	}
+}
+
+// Submit queues task. It never blocks and never drops tasks. Submit panics
+// if called after Wait.
+func (p *WorkerPool[T, R]) Submit(task T) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closing {
+		panic("async: Submit after Wait")
+	}
+	p.tasks.Enqueue(task)
+	p.cond.Signal()
+}
+
+// Results returns the channel on which the results of all tasks arrive. It
+// is closed by Wait.
+func (p *WorkerPool[T, R]) Results() <-chan result.Result[R] {
+	return p.results
+}
+
+// Wait blocks until all submitted tasks are done, then stops the workers
+// and closes the results channel. Calling Wait more than once has no
+// further effect.
+func (p *WorkerPool[T, R]) Wait() {
+	p.once.Do(func() {
+		p.mu.Lock()
+		p.closing = true
+		p.cond.Broadcast()
+		p.mu.Unlock()
+		p.workers.Wait()
+		close(p.results)
+	})
+}
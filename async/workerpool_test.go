@@ -0,0 +1,145 @@
+package async
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"slices"
+	"testing"
+
+	"projektarbeit-go-generics/result"
+)
+
+// collectResults receives every result of p in the background. The
+// returned function waits for the pool and returns the results.
+func collectResults[T, R any](p *WorkerPool[T, R]) func() []result.Result[R] {
+	done := make(chan []result.Result[R])
+	go func() {
+		var rs []result.Result[R]
+		for r := range p.Results() {
+			rs = append(rs, r)
+		}
+		done <- rs
+	}()
+	return func() []result.Result[R] {
+		p.Wait()
+		return <-done
+	}
+}
+
+var errOdd = errors.New("odd")
+
+func TestWorkerPoolAllTasks(t *testing.T) {
+	verifyNoLeaks(t)
+	p := NewWorkerPool(4, func(n int) (int, error) {
+		if n%2 != 0 {
+			return 0, errOdd
+		}
+		return n * n, nil
+	})
+	wait := collectResults(p)
+	for i := range 100 {
+		p.Submit(i)
+	}
+	var squares []int
+	errs := 0
+	for _, r := range wait() {
+		v, err := r.TryUnwrap()
+		switch {
+		case errors.Is(err, errOdd):
+			errs++
+		case err != nil:
+			t.Errorf("unexpected error %v", err)
+		default:
+			squares = append(squares, v)
+		}
+	}
+	slices.Sort(squares)
+	if len(squares) != 50 || squares[0] != 0 || squares[49] != 98*98 || errs != 50 {
+		t.Errorf("got %d squares and %d errors, want 50 of each", len(squares), errs)
+	}
+	p.Wait() // no further effect
+}
+
+func TestWorkerPoolWithContext(t *testing.T) {
+	verifyNoLeaks(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	started, release := make(chan struct{}), make(chan struct{})
+	p := NewWorkerPool(1, func(n int) (int, error) {
+		if n == 0 {
+			close(started)
+			<-release
+		}
+		return n, nil
+	}, WithContext(ctx))
+	wait := collectResults(p)
+	for i := range 5 {
+		p.Submit(i)
+	}
+	<-started
+	cancel()
+	close(release)
+
+	var ok, canceled int
+	for _, r := range wait() {
+		if _, err := r.TryUnwrap(); errors.Is(err, context.Canceled) {
+			canceled++
+		} else if err == nil {
+			ok++
+		}
+	}
+	if ok != 1 || canceled != 4 {
+		t.Errorf("got %d results and %d canceled tasks, want 1 and 4", ok, canceled)
+	}
+}
+
+func TestWorkerPoolPanics(t *testing.T) {
+	verifyNoLeaks(t)
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("NewWorkerPool(0) did not panic")
+			}
+		}()
+		NewWorkerPool(0, func(int) (int, error) { return 0, nil })
+	}()
+
+	p := NewWorkerPool(1, func(int) (int, error) { return 0, nil })
+	p.Wait()
+	defer func() {
+		if recover() == nil {
+			t.Error("Submit after Wait did not panic")
+		}
+	}()
+	p.Submit(1)
+}
+
+// spin is a CPU-bound task.
+func spin(n int) (int, error) {
+	x := n
+	for i := range 20000 {
+		x = x*31 + i
+	}
+	return x, nil
+}
+
+// BenchmarkWorkerPool runs CPU-bound tasks with 1 up to runtime.NumCPU()
+// workers. The time per task should drop about linearly with the number of
+// workers until it reaches the number of CPUs.
+func BenchmarkWorkerPool(b *testing.B) {
+	for w := 1; ; w *= 2 {
+		w := min(w, runtime.NumCPU())
+		b.Run(fmt.Sprintf("workers=%d", w), func(b *testing.B) {
+			p := NewWorkerPool(w, spin)
+			wait := collectResults(p)
+			for i := range b.N {
+				p.Submit(i)
+			}
+			wait()
+		})
+		if w == runtime.NumCPU() {
+			return
+		}
+	}
+}
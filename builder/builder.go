@@ -0,0 +1,49 @@
+// Package builder provides a generic fluent builder for option structs.
+package builder
+
+// Builder accumulates changes to a value of type T and hands out validated
+// copies of it.
+type Builder[T any] struct {
+	value    T
+	validate func(T) error
+}
+
+// NewBuilder returns a builder that starts from base.
+func NewBuilder[T any](base T) *Builder[T] {
+	return &Builder[T]{value: base}
+}
+
+// Apply calls opt on the value being built.
+func (b *Builder[T]) Apply(opt func(*T)) *Builder[T] {
+	opt(&b.value)
+	return b
+}
+
+// With calls all opts on the value being built, in order.
+func (b *Builder[T]) With(opts ...func(*T)) *Builder[T] {
+	for _, opt := range opts {
+		opt(&b.value)
+	}
+	return b
+}
+
+// Validate registers a check that Build runs before returning the value. A
+// later call replaces the previous check.
+func (b *Builder[T]) Validate(validate func(T) error) *Builder[T] {
+	b.validate = validate
+	return b
+}
+
+// Build returns a copy of the value built so far. Later calls to Apply do
+// not affect values returned earlier, but the copy is shallow: pointers,
+// slices and maps in T are shared. If a validator is registered and
+// rejects the value, Build returns its error along with the value.
+func (b *Builder[T]) Build() (T, error) {
+	v := b.value
+	if b.validate != nil {
+		if err := b.validate(v); err != nil {
+			return v, err
+		}
+	}
+	return v, nil
+}
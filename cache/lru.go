@@ -0,0 +1,172 @@
+// Package cache provides generic in-memory caches.
+package cache
+
+import (
+	"fmt"
+	"sync"
+)
+
+type options struct {
+	mutex bool
+}
+
+// Option configures an LRUCache.
+type Option func(*options)
+
+// WithMutex makes the cache safe for concurrent use. Without it no locking
+// is done and the cache must only be used from a single goroutine.
+func WithMutex() Option {
+	return func(o *options) {
+		o.mutex = true
+	}
+}
+
+type node[K comparable, V any] struct {
+	key        K
+	value      V
+	prev, next *node[K, V]
+}
+
+// LRUCache is a fixed-capacity cache that evicts the least recently used
+// entry when full. Get and Put both count as a use.
+type LRUCache[K comparable, V any] struct {
+	mu       *sync.Mutex
+	capacity int
+	items    map[K]*node[K, V]
+	root     node[K, V] // Sentinel: root.next is the most, root.prev the least recently used entry
+}
+
+// NewLRUCache returns an empty cache holding at most capacity entries.
+func NewLRUCache[K comparable, V any](capacity int, opts ...Option) *LRUCache[K, V] {
+	if capacity <= 0 {
+		panic(fmt.Sprintf("cache: invalid LRUCache capacity %d", capacity))
+	}
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	c := &LRUCache[K, V]{capacity: capacity, items: make(map[K]*node[K, V], capacity)}
+	c.root.next = &c.root
+	c.root.prev = &c.root
+	if o.mutex {
+		c.mu = &sync.Mutex{}
+	}
+	return c
+}
+
+func (c *LRUCache[K, V]) lock() {
+	if c.mu != nil {
+		c.mu.Lock()
+	}
+}
+
+func (c *LRUCache[K, V]) unlock() {
+	if c.mu != nil {
+		c.mu.Unlock()
+	}
+}
+
+// Get returns the value stored for key and marks it as most recently used.
+func (c *LRUCache[K, V]) Get(key K) (V, bool) {
+	c.lock()
+	defer c.unlock()
+
+	n, ok := c.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	c.moveToFront(n)
+	return n.value, true
+}
+
+// Peek returns the value stored for key without changing the LRU order.
+func (c *LRUCache[K, V]) Peek(key K) (V, bool) {
+	c.lock()
+	defer c.unlock()
+
+	n, ok := c.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return n.value, true
+}
+
+// Put stores value for key and marks it as most recently used, evicting the
+// least recently used entry if the cache is full.
+func (c *LRUCache[K, V]) Put(key K, value V) {
+	c.lock()
+	defer c.unlock()
+
+	if n, ok := c.items[key]; ok {
+		n.value = value
+		c.moveToFront(n)
+		return
+	}
+	n := &node[K, V]{key: key, value: value}
+	c.items[key] = n
+	c.insertFront(n)
+	c.evict()
+}
+
+// Delete removes key from the cache.
+func (c *LRUCache[K, V]) Delete(key K) {
+	c.lock()
+	defer c.unlock()
+
+	if n, ok := c.items[key]; ok {
+		c.remove(n)
+	}
+}
+
+// Len returns the number of entries in the cache.
+func (c *LRUCache[K, V]) Len() int {
+	c.lock()
+	defer c.unlock()
+
+	return len(c.items)
+}
+
+// Resize changes the capacity of the cache, evicting the least recently
+// used entries if it holds more than newCap.
+func (c *LRUCache[K, V]) Resize(newCap int) {
+	if newCap <= 0 {
+		panic(fmt.Sprintf("cache: invalid LRUCache capacity %d", newCap))
+	}
+	c.lock()
+	defer c.unlock()
+
+	c.capacity = newCap
+	c.evict()
+}
+
+func (c *LRUCache[K, V]) evict() {
+	for len(c.items) > c.capacity {
+		c.remove(c.root.prev)
+	}
+}
+
+func (c *LRUCache[K, V]) insertFront(n *node[K, V]) {
+	n.prev = &c.root
+	n.next = c.root.next
+	n.prev.next = n
+	n.next.prev = n
+}
+
+func (c *LRUCache[K, V]) unlink(n *node[K, V]) {
+	n.prev.next = n.next
+	n.next.prev = n.prev
+	n.prev, n.next = nil, nil
+}
+
+func (c *LRUCache[K, V]) moveToFront(n *node[K, V]) {
+	c.unlink(n)
+	c.insertFront(n)
+}
+
+func (c *LRUCache[K, V]) remove(n *node[K, V]) {
+	c.unlink(n)
+	delete(c.items, n.key)
+}
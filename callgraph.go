@@ -0,0 +1,102 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"os"
+
+	"projektarbeit-go-generics/graph"
+)
+
+// CallEdge is a single call site. ViaInterface is set for calls of
+// interface methods, including methods of type parameter constraints,
+// whose target is only known at run time.
+type CallEdge struct {
+	Pos          token.Position
+	ViaInterface bool
+}
+
+// BuildCallGraph returns the static call graph of files. Nodes are function
+// names as returned by types.Func.FullName, e.g. main.printObj or
+// (*go/types.Config).Check. Calls inside function literals are attributed
+// to the enclosing declaration. Calls of builtins, conversions and function
+// values cannot be resolved statically and are left out, as are calls in
+// package-level variable initializers.
+func BuildCallGraph(fset *token.FileSet, info *types.Info, files []*ast.File) *graph.Graph[string, CallEdge] {
+	g := graph.NewGraph[string, CallEdge]()
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || fd.Body == nil {
+				continue
+			}
+			caller, ok := info.Defs[fd.Name].(*types.Func)
+			if !ok {
+				continue
+			}
+			g.AddNode(caller.FullName())
+			ast.Inspect(fd.Body, func(n ast.Node) bool {
+				call, ok := n.(*ast.CallExpr)
+				if !ok {
+					return true
+				}
+				if callee := calledFunc(info, call.Fun); callee != nil {
+					edge := CallEdge{Pos: fset.Position(call.Lparen), ViaInterface: isInterfaceMethod(callee)}
+					// Methods of instantiated types are named after their
+					// generic origin, e.g. (*main.Stack[T]).Push.
+					g.AddEdge(caller.FullName(), callee.Origin().FullName(), edge)
+				}
+				return true
+			})
+		}
+	}
+	return g
+}
+
+// calledFunc resolves the function named by the callee expression of a
+// call, unwrapping parentheses and explicit instantiations like f[int].
+func calledFunc(info *types.Info, fun ast.Expr) *types.Func {
+	for {
+		switch e := ast.Unparen(fun).(type) {
+		case *ast.Ident:
+			fn, _ := info.Uses[e].(*types.Func)
+			return fn
+		case *ast.SelectorExpr:
+			fn, _ := info.Uses[e.Sel].(*types.Func)
+			return fn
+		case *ast.IndexExpr:
+			fun = e.X
+		case *ast.IndexListExpr:
+			fun = e.X
+		default:
+			return nil
+		}
+	}
+}
+
+func isInterfaceMethod(fn *types.Func) bool {
+	recv := fn.Type().(*types.Signature).Recv()
+	return recv != nil && types.IsInterface(recv.Type())
+}
+
+func printCallGraph(g *graph.Graph[string, CallEdge]) error {
+	for _, caller := range g.Nodes() {
+		for _, e := range g.Edges(caller) {
+			if *output == "json" {
+				m := map[string]any{"caller": caller, "callee": e.To, "pos": e.Value.Pos.String(), "viaInterface": e.Value.ViaInterface}
+				if err := jsonLines.Encode(os.Stdout, m); err != nil {
+					return err
+				}
+				continue
+			}
+			via := ""
+			if e.Value.ViaInterface {
+				via = " (via interface)"
+			}
+			fmt.Printf("%s,\t%s -> %s%s\n", e.Value.Pos, caller, e.To, via)
+		}
+	}
+	return nil
+}
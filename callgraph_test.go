@@ -0,0 +1,84 @@
+package main
+
+import "testing"
+
+const callGraphSource = `package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+)
+
+type Stack[T any] struct{ items []T }
+
+func (s *Stack[T]) Push(v T) { s.items = append(s.items, v) }
+
+func formatObj(obj types.Object) string { return obj.String() }
+
+func printObj(obj types.Object) { fmt.Println(formatObj(obj)) }
+
+func inspectCode(code string) error {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "input.go", code, 0)
+	if err != nil {
+		return err
+	}
+	conf := types.Config{}
+	pkg, err := conf.Check("main", fset, []*ast.File{f}, nil)
+	if err != nil {
+		return err
+	}
+	scope := pkg.Scope()
+	_, obj := scope.LookupParent("x", token.NoPos)
+	printObj(obj)
+	var s Stack[int]
+	s.Push(1)
+	func() { fmt.Println(obj.Name()) }()
+	return nil
+}
+`
+
+func TestBuildCallGraph(t *testing.T) {
+	c := checkSource(t, callGraphSource)
+	g := BuildCallGraph(c.fset, c.info, c.files)
+
+	callees := make(map[string]CallEdge)
+	for _, e := range g.Edges("main.inspectCode") {
+		callees[e.To] = e.Value
+	}
+	for _, want := range []struct {
+		callee       string
+		viaInterface bool
+	}{
+		{"go/token.NewFileSet", false},
+		{"go/parser.ParseFile", false},
+		{"(*go/types.Config).Check", false},
+		{"(*go/types.Scope).LookupParent", false},
+		{"main.printObj", false},
+		{"(*main.Stack[T]).Push", false},
+		{"fmt.Println", false},
+		{"(go/types.Object).Name", true},
+	} {
+		e, ok := callees[want.callee]
+		if !ok {
+			t.Errorf("main.inspectCode does not call %s, callees are %v", want.callee, g.Neighbors("main.inspectCode"))
+			continue
+		}
+		if e.ViaInterface != want.viaInterface {
+			t.Errorf("call of %s: ViaInterface = %v, want %v", want.callee, e.ViaInterface, want.viaInterface)
+		}
+		if e.Pos.Filename != "input.go" || e.Pos.Line == 0 {
+			t.Errorf("call of %s: Pos = %v, want a position in input.go", want.callee, e.Pos)
+		}
+	}
+
+	if got := g.Neighbors("main.printObj"); len(got) != 2 || got[0] != "fmt.Println" || got[1] != "main.formatObj" {
+		t.Errorf("callees of main.printObj = %v, want [fmt.Println main.formatObj]", got)
+	}
+	if got := g.Neighbors("main.formatObj"); len(got) != 1 || got[0] != "(go/types.Object).String" {
+		t.Errorf("callees of main.formatObj = %v, want [(go/types.Object).String]", got)
+	}
+}
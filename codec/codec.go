@@ -0,0 +1,105 @@
+// Package codec provides typed encoders and decoders on top of the standard
+// library's encoding packages.
+package codec
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"io"
+)
+
+// Encoder writes values of type T to a stream.
+type Encoder[T any] interface {
+	Encode(w io.Writer, v T) error
+}
+
+// Decoder reads values of type T from a stream.
+type Decoder[T any] interface {
+	Decode(r io.Reader) (T, error)
+}
+
+// JSONEncoder encodes values as JSON, one value per line unless Indent is
+// set.
+type JSONEncoder[T any] struct {
+	Prefix, Indent string
+}
+
+// Encode writes the JSON encoding of v to w, followed by a newline.
+func (e JSONEncoder[T]) Encode(w io.Writer, v T) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent(e.Prefix, e.Indent)
+	return enc.Encode(v)
+}
+
+// JSONDecoder decodes JSON values. It keeps its json.Decoder for as long as
+// Decode is called with the same reader, so that consecutive calls read
+// consecutive values even though json.Decoder reads ahead. A JSONDecoder is
+// not safe for concurrent use.
+type JSONDecoder[T any] struct {
+	// DisallowUnknownFields makes Decode fail on object keys that do not
+	// match a field of T.
+	DisallowUnknownFields bool
+
+	r   io.Reader
+	dec *json.Decoder
+}
+
+// Decode reads the next JSON value from r.
+func (d *JSONDecoder[T]) Decode(r io.Reader) (T, error) {
+	if d.dec == nil || d.r != r {
+		d.r, d.dec = r, json.NewDecoder(r)
+		if d.DisallowUnknownFields {
+			d.dec.DisallowUnknownFields()
+		}
+	}
+	var v T
+	err := d.dec.Decode(&v)
+	return v, err
+}
+
+// GobEncoder encodes values with encoding/gob. Like a gob.Encoder it sends
+// the type information only once per writer, so the values written to one
+// writer form a single stream that should be read by one GobDecoder. A
+// GobEncoder is not safe for concurrent use.
+type GobEncoder[T any] struct {
+	w   io.Writer
+	enc *gob.Encoder
+}
+
+// Encode writes the gob encoding of v to w.
+func (e *GobEncoder[T]) Encode(w io.Writer, v T) error {
+	if e.enc == nil || e.w != w {
+		e.w, e.enc = w, gob.NewEncoder(w)
+	}
+	return e.enc.Encode(v)
+}
+
+// GobDecoder decodes values written by GobEncoder. It keeps its gob.Decoder
+// for as long as Decode is called with the same reader. A GobDecoder is not
+// safe for concurrent use.
+type GobDecoder[T any] struct {
+	r   io.Reader
+	dec *gob.Decoder
+}
+
+// Decode reads the next value of the gob stream in r.
+func (d *GobDecoder[T]) Decode(r io.Reader) (T, error) {
+	if d.dec == nil || d.r != r {
+		d.r, d.dec = r, gob.NewDecoder(r)
+	}
+	var v T
+	err := d.dec.Decode(&v)
+	return v, err
+}
+
+// RoundTrip encodes v with enc and decodes the result with dec, which is
+// useful to check that a codec preserves values of type T.
+func RoundTrip[T any](enc Encoder[T], dec Decoder[T], v T) (T, error) {
+	var buf bytes.Buffer
+	if err := enc.Encode(&buf, v); err != nil {
+		var zero T
+		return zero, err
+	}
+	return dec.Decode(&buf)
+}
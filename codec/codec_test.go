@@ -0,0 +1,100 @@
+package codec
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type object struct {
+	Name     string
+	Kind     string
+	Exported bool
+	Params   []string
+}
+
+var objects = []object{
+	{Name: "isEven", Kind: "Func", Params: []string{"n"}},
+	{Name: "MyInt", Kind: "TypeName", Exported: true},
+}
+
+func TestRoundTrip(t *testing.T) {
+	for name, codec := range map[string]struct {
+		enc Encoder[object]
+		dec Decoder[object]
+	}{
+		"json":          {JSONEncoder[object]{}, &JSONDecoder[object]{}},
+		"indented json": {JSONEncoder[object]{Indent: "  "}, &JSONDecoder[object]{}},
+		"gob":           {&GobEncoder[object]{}, &GobDecoder[object]{}},
+	} {
+		for _, v := range objects {
+			got, err := RoundTrip(codec.enc, codec.dec, v)
+			if err != nil || !reflect.DeepEqual(got, v) {
+				t.Errorf("%s: RoundTrip(%+v) = %+v, %v", name, v, got, err)
+			}
+		}
+	}
+}
+
+// TestStream writes several values to one stream and reads them back with
+// repeated Decode calls, which only works if the decoder state, including
+// data it has read ahead, is kept between calls.
+func TestStream(t *testing.T) {
+	for name, codec := range map[string]struct {
+		enc Encoder[object]
+		dec Decoder[object]
+	}{
+		"json": {JSONEncoder[object]{}, &JSONDecoder[object]{}},
+		"gob":  {&GobEncoder[object]{}, &GobDecoder[object]{}},
+	} {
+		var buf bytes.Buffer
+		for _, v := range objects {
+			if err := codec.enc.Encode(&buf, v); err != nil {
+				t.Fatalf("%s: Encode: %v", name, err)
+			}
+		}
+		for _, want := range objects {
+			got, err := codec.dec.Decode(&buf)
+			if err != nil || !reflect.DeepEqual(got, want) {
+				t.Errorf("%s: Decode = %+v, %v, want %+v", name, got, err, want)
+			}
+		}
+		if _, err := codec.dec.Decode(&buf); err != io.EOF {
+			t.Errorf("%s: Decode at the end of the stream error = %v, want EOF", name, err)
+		}
+	}
+}
+
+func TestJSONEncoderFormat(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (JSONEncoder[map[string]int]{}).Encode(&buf, map[string]int{"b": 2, "a": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := buf.String(), "{\"a\":1,\"b\":2}\n"; got != want {
+		t.Errorf("Encode wrote %q, want %q", got, want)
+	}
+}
+
+func TestJSONDecoderDisallowUnknownFields(t *testing.T) {
+	const input = `{"Name": "x", "Unknown": 1}`
+	if _, err := (&JSONDecoder[object]{}).Decode(strings.NewReader(input)); err != nil {
+		t.Errorf("Decode with unknown fields allowed: %v", err)
+	}
+	if _, err := (&JSONDecoder[object]{DisallowUnknownFields: true}).Decode(strings.NewReader(input)); err == nil {
+		t.Error("Decode with unknown fields disallowed succeeded")
+	}
+}
+
+func TestDecoderSwitchesReaders(t *testing.T) {
+	dec := &JSONDecoder[int]{}
+	for _, tt := range []struct {
+		input string
+		want  int
+	}{{"1 2", 1}, {"3", 3}} {
+		if got, err := dec.Decode(strings.NewReader(tt.input)); got != tt.want || err != nil {
+			t.Errorf("Decode(%q) = %d, %v, want %d, nil", tt.input, got, err, tt.want)
+		}
+	}
+}
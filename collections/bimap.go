@@ -0,0 +1,95 @@
+package collections
+
+import "iter"
+
+// BiMap is a one-to-one mapping between keys of type A and keys of type B
+// that can be looked up in both directions. The zero value is not usable;
+// create one with NewBiMap.
+type BiMap[A, B comparable] struct {
+	forward map[A]B
+	inverse map[B]A
+}
+
+// NewBiMap returns an empty BiMap.
+func NewBiMap[A, B comparable]() *BiMap[A, B] {
+	return &BiMap[A, B]{forward: make(map[A]B), inverse: make(map[B]A)}
+}
+
+// PutAB maps a to b. It returns false and leaves the map unchanged if a or
+// b is already mapped to something else.
+func (m *BiMap[A, B]) PutAB(a A, b B) bool {
+	if old, ok := m.forward[a]; ok && old != b {
+		return false
+	}
+	if old, ok := m.inverse[b]; ok && old != a {
+		return false
+	}
+	m.forward[a] = b
+	m.inverse[b] = a
+	return true
+}
+
+// ForcePutAB maps a to b and removes any pairs that contained a or b. It
+// reports whether such a conflicting pair was removed.
+func (m *BiMap[A, B]) ForcePutAB(a A, b B) bool {
+	conflict := false
+	if old, ok := m.forward[a]; ok && old != b {
+		delete(m.inverse, old)
+		conflict = true
+	}
+	if old, ok := m.inverse[b]; ok && old != a {
+		delete(m.forward, old)
+		conflict = true
+	}
+	m.forward[a] = b
+	m.inverse[b] = a
+	return conflict
+}
+
+// GetByA returns the B key mapped to a.
+func (m *BiMap[A, B]) GetByA(a A) (B, bool) {
+	b, ok := m.forward[a]
+	return b, ok
+}
+
+// GetByB returns the A key mapped to b.
+func (m *BiMap[A, B]) GetByB(b B) (A, bool) {
+	a, ok := m.inverse[b]
+	return a, ok
+}
+
+// DeleteByA removes the pair containing a and reports whether it existed.
+func (m *BiMap[A, B]) DeleteByA(a A) bool {
+	b, ok := m.forward[a]
+	if ok {
+		delete(m.forward, a)
+		delete(m.inverse, b)
+	}
+	return ok
+}
+
+// DeleteByB removes the pair containing b and reports whether it existed.
+func (m *BiMap[A, B]) DeleteByB(b B) bool {
+	a, ok := m.inverse[b]
+	if ok {
+		delete(m.forward, a)
+		delete(m.inverse, b)
+	}
+	return ok
+}
+
+// Len returns the number of pairs.
+func (m *BiMap[A, B]) Len() int {
+	return len(m.forward)
+}
+
+// All yields all pairs in unspecified order.
+func (m *BiMap[A, B]) All() iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		for a, b := range m.forward {
+			if !yield(a, b) {
+				return
+			}
+		}
+	}
+}
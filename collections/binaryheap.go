@@ -0,0 +1,71 @@
+package collections
+
+import "cmp"
+
+// Heap is a binary heap ordered by a comparator: Pop returns the minimum
+// for a min-heap and the maximum for a max-heap. Two heaps merge in linear
+// time. PriorityQueue extends it with in-place updates.
+type Heap[T any] struct {
+	elems []T
+	less  func(T, T) bool
+}
+
+// HeapWith builds a heap of elems ordered by less in O(n).
+func HeapWith[T any](less func(T, T) bool, elems ...T) *Heap[T] {
+	h := &Heap[T]{elems: append([]T(nil), elems...), less: less}
+	heapify(h.elems, h.less)
+	return h
+}
+
+// NewMinHeap builds a heap of elems whose Pop returns the smallest element.
+func NewMinHeap[T cmp.Ordered](elems ...T) *Heap[T] {
+	return HeapWith(cmp.Less[T], elems...)
+}
+
+// NewMaxHeap builds a heap of elems whose Pop returns the largest element.
+func NewMaxHeap[T cmp.Ordered](elems ...T) *Heap[T] {
+	return HeapWith(func(a, b T) bool { return cmp.Less(b, a) }, elems...)
+}
+
+// Push adds v to the heap.
+func (h *Heap[T]) Push(v T) {
+	h.elems = append(h.elems, v)
+	siftUp(h.elems, len(h.elems)-1, h.less)
+}
+
+// Pop removes and returns the top element. It returns the zero value and
+// false if the heap is empty.
+func (h *Heap[T]) Pop() (T, bool) {
+	var zero T
+	if len(h.elems) == 0 {
+		return zero, false
+	}
+	top := h.elems[0]
+	last := len(h.elems) - 1
+	h.elems[0] = h.elems[last]
+	h.elems[last] = zero
+	h.elems = h.elems[:last]
+	siftDown(h.elems, 0, h.less)
+	return top, true
+}
+
+// Peek returns the top element without removing it.
+func (h *Heap[T]) Peek() (T, bool) {
+	if len(h.elems) == 0 {
+		var zero T
+		return zero, false
+	}
+	return h.elems[0], true
+}
+
+// Len returns the number of elements in the heap.
+func (h *Heap[T]) Len() int {
+	return len(h.elems)
+}
+
+// Merge adds all elements of other to h in O(n+m) by rebuilding the heap.
+// The elements are ordered by the comparator of h; other is left unchanged.
+func (h *Heap[T]) Merge(other *Heap[T]) {
+	h.elems = append(h.elems, other.elems...)
+	heapify(h.elems, h.less)
+}
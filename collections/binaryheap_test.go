@@ -0,0 +1,99 @@
+package collections
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"testing"
+)
+
+// checkHeap fails the test if some element of h is ordered before its
+// parent.
+func checkHeap[T any](t *testing.T, h *Heap[T]) {
+	t.Helper()
+	for i := 1; i < len(h.elems); i++ {
+		if parent := (i - 1) / 2; h.less(h.elems[i], h.elems[parent]) {
+			t.Fatalf("heap property violated at index %d: %v", i, h.elems)
+		}
+	}
+}
+
+func drain[T any](h *Heap[T]) []T {
+	var s []T
+	for h.Len() > 0 {
+		v, _ := h.Pop()
+		s = append(s, v)
+	}
+	return s
+}
+
+func customIntLess(a, b customInt[int]) bool    { return a.value < b.value }
+func customIntGreater(a, b customInt[int]) bool { return a.value > b.value }
+
+func TestHeapCustomInt(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		less func(a, b customInt[int]) bool
+	}{{"min", customIntLess}, {"max", customIntGreater}} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := rand.New(rand.NewPCG(1, 2))
+			var initial []customInt[int]
+			for range 20 {
+				initial = append(initial, customInt[int]{r.IntN(100)})
+			}
+			h := HeapWith(tc.less, initial...)
+			checkHeap(t, h)
+			for range 200 {
+				h.Push(customInt[int]{r.IntN(100)})
+				checkHeap(t, h)
+				before, _ := h.Peek()
+				if v, ok := h.Pop(); !ok || v != before {
+					t.Fatalf("Pop() = %v, %v, want Peek() result %v", v, ok, before)
+				}
+				checkHeap(t, h)
+			}
+			got := drain(h)
+			for i := 1; i < len(got); i++ {
+				if tc.less(got[i], got[i-1]) {
+					t.Fatalf("popped out of order: %v", got)
+				}
+			}
+		})
+	}
+}
+
+func TestMinMaxHeap(t *testing.T) {
+	if got, want := drain(NewMinHeap(5, 1, 4, 2, 3)), []int{1, 2, 3, 4, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("NewMinHeap pops = %v, want %v", got, want)
+	}
+	if got, want := drain(NewMaxHeap("b", "c", "a")), []string{"c", "b", "a"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("NewMaxHeap pops = %v, want %v", got, want)
+	}
+	elems := []int{3, 2, 1}
+	NewMinHeap(elems...)
+	if !reflect.DeepEqual(elems, []int{3, 2, 1}) {
+		t.Errorf("NewMinHeap modified its arguments: %v", elems)
+	}
+}
+
+func TestHeapEmpty(t *testing.T) {
+	h := NewMinHeap[int]()
+	if _, ok := h.Pop(); ok {
+		t.Error("Pop() of an empty heap reported a value")
+	}
+	if _, ok := h.Peek(); ok {
+		t.Error("Peek() of an empty heap reported a value")
+	}
+}
+
+func TestHeapMerge(t *testing.T) {
+	h := NewMaxHeap(1, 5, 3)
+	other := NewMinHeap(4, 2, 6)
+	h.Merge(other)
+	checkHeap(t, h)
+	if got, want := drain(h), []int{6, 5, 4, 3, 2, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("merged pops = %v, want %v", got, want)
+	}
+	if got, want := drain(other), []int{2, 4, 6}; !reflect.DeepEqual(got, want) {
+		t.Errorf("other after Merge pops = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,133 @@
+package collections
+
+import "iter"
+
+// Deque is a double-ended queue backed by a ring buffer whose capacity is
+// always a power of two, so pushes and pops at both ends run in amortized
+// constant time. The zero value is an empty deque ready to use. A Deque is
+// not safe for concurrent use.
+type Deque[T any] struct {
+	buf  []T
+	head int
+	len  int
+}
+
+// DequeFromSlice returns a deque holding the elements of s, front to back.
+func DequeFromSlice[T any](s []T) *Deque[T] {
+	capacity := minQueueCap
+	for capacity < len(s) {
+		capacity *= 2
+	}
+	buf := make([]T, capacity)
+	copy(buf, s)
+	return &Deque[T]{buf: buf, len: len(s)}
+}
+
+func (d *Deque[T]) index(i int) int {
+	return (d.head + i) & (len(d.buf) - 1)
+}
+
+// PushFront adds v at the front.
+func (d *Deque[T]) PushFront(v T) {
+	if d.len == len(d.buf) {
+		d.grow()
+	}
+	d.head = d.index(len(d.buf) - 1)
+	d.buf[d.head] = v
+	d.len++
+}
+
+// PushBack adds v at the back.
+func (d *Deque[T]) PushBack(v T) {
+	if d.len == len(d.buf) {
+		d.grow()
+	}
+	d.buf[d.index(d.len)] = v
+	d.len++
+}
+
+// PopFront removes and returns the front element. It returns the zero value
+// and false if the deque is empty.
+func (d *Deque[T]) PopFront() (T, bool) {
+	var zero T
+	if d.len == 0 {
+		return zero, false
+	}
+	v := d.buf[d.head]
+	d.buf[d.head] = zero
+	d.head = d.index(1)
+	d.len--
+	return v, true
+}
+
+// PopBack removes and returns the back element. It returns the zero value
+// and false if the deque is empty.
+func (d *Deque[T]) PopBack() (T, bool) {
+	var zero T
+	if d.len == 0 {
+		return zero, false
+	}
+	i := d.index(d.len - 1)
+	v := d.buf[i]
+	d.buf[i] = zero
+	d.len--
+	return v, true
+}
+
+// PeekFront returns the front element without removing it.
+func (d *Deque[T]) PeekFront() (T, bool) {
+	if d.len == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.buf[d.head], true
+}
+
+// PeekBack returns the back element without removing it.
+func (d *Deque[T]) PeekBack() (T, bool) {
+	if d.len == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.buf[d.index(d.len-1)], true
+}
+
+// Len returns the number of elements in the deque.
+func (d *Deque[T]) Len() int {
+	return d.len
+}
+
+// IsEmpty reports whether the deque holds no elements.
+func (d *Deque[T]) IsEmpty() bool {
+	return d.len == 0
+}
+
+// ToSlice returns the elements from front to back.
+func (d *Deque[T]) ToSlice() []T {
+	s := make([]T, 0, d.len)
+	for v := range d.All() {
+		s = append(s, v)
+	}
+	return s
+}
+
+// All returns an iterator over the elements from front to back.
+func (d *Deque[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := range d.len {
+			if !yield(d.buf[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// grow doubles the capacity and moves the elements into logical order
+// starting at index 0.
+func (d *Deque[T]) grow() {
+	buf := make([]T, max(2*len(d.buf), minQueueCap))
+	n := copy(buf, d.buf[d.head:])
+	copy(buf[n:], d.buf[:d.head])
+	d.buf = buf
+	d.head = 0
+}
@@ -0,0 +1,144 @@
+package collections
+
+import (
+	"container/list"
+	"reflect"
+	"testing"
+)
+
+func TestDequeBothEnds(t *testing.T) {
+	var d Deque[int]
+	if !d.IsEmpty() {
+		t.Error("zero Deque is not empty")
+	}
+	for i := range 10 {
+		d.PushBack(i)
+		d.PushFront(-i - 1)
+	}
+	want := []int{-10, -9, -8, -7, -6, -5, -4, -3, -2, -1, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	if got := d.ToSlice(); !reflect.DeepEqual(got, want) {
+		t.Errorf("ToSlice() = %v, want %v", got, want)
+	}
+	if v, ok := d.PeekFront(); !ok || v != -10 {
+		t.Errorf("PeekFront() = %d, %v, want -10, true", v, ok)
+	}
+	if v, ok := d.PeekBack(); !ok || v != 9 {
+		t.Errorf("PeekBack() = %d, %v, want 9, true", v, ok)
+	}
+	for _, w := range want[:5] {
+		if v, _ := d.PopFront(); v != w {
+			t.Errorf("PopFront() = %d, want %d", v, w)
+		}
+	}
+	for i := len(want) - 1; i >= 5; i-- {
+		if v, _ := d.PopBack(); v != want[i] {
+			t.Errorf("PopBack() = %d, want %d", v, want[i])
+		}
+	}
+	if d.Len() != 0 || !d.IsEmpty() {
+		t.Errorf("Len() = %d after popping everything, want 0", d.Len())
+	}
+}
+
+func TestDequeEmpty(t *testing.T) {
+	var d Deque[string]
+	if _, ok := d.PopFront(); ok {
+		t.Error("PopFront() of an empty deque reported a value")
+	}
+	if _, ok := d.PopBack(); ok {
+		t.Error("PopBack() of an empty deque reported a value")
+	}
+	if _, ok := d.PeekFront(); ok {
+		t.Error("PeekFront() of an empty deque reported a value")
+	}
+	if _, ok := d.PeekBack(); ok {
+		t.Error("PeekBack() of an empty deque reported a value")
+	}
+	if got := d.ToSlice(); len(got) != 0 {
+		t.Errorf("ToSlice() = %v, want empty", got)
+	}
+}
+
+// TestDequeGrowWrapped grows the buffer while the elements wrap around its
+// end.
+func TestDequeGrowWrapped(t *testing.T) {
+	d := DequeFromSlice([]int{1, 2, 3, 4, 5, 6, 7, 8})
+	d.PopFront()
+	d.PopFront()
+	d.PushBack(9)
+	d.PushBack(10)
+	d.PushFront(2)
+	want := []int{2, 3, 4, 5, 6, 7, 8, 9, 10}
+	if got := d.ToSlice(); !reflect.DeepEqual(got, want) {
+		t.Errorf("ToSlice() = %v, want %v", got, want)
+	}
+}
+
+func TestDequeFromSlice(t *testing.T) {
+	s := []customInt[int16]{{1}, {2}, {3}}
+	d := DequeFromSlice(s)
+	s[0] = customInt[int16]{9}
+	if got, want := d.ToSlice(), []customInt[int16]{{1}, {2}, {3}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ToSlice() = %v, want %v", got, want)
+	}
+	d.PushFront(customInt[int16]{0})
+	if v, _ := d.PeekFront(); v != (customInt[int16]{0}) {
+		t.Errorf("PeekFront() = %v, want {0}", v)
+	}
+	if d := DequeFromSlice[int](nil); !d.IsEmpty() {
+		t.Error("DequeFromSlice(nil) is not empty")
+	}
+}
+
+func TestDequeAllBreak(t *testing.T) {
+	d := DequeFromSlice([]int{1, 2, 3})
+	var got []int
+	for v := range d.All() {
+		got = append(got, v)
+		if v == 2 {
+			break
+		}
+	}
+	if want := []int{1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("All() with break = %v, want %v", got, want)
+	}
+}
+
+// The deque benchmarks alternate between the two ends and keep up to
+// benchQueueLen elements, comparing the ring buffer with a doubly-linked
+// list.
+func BenchmarkDeque(b *testing.B) {
+	var d Deque[int]
+	for i := range b.N {
+		if i%2 == 0 {
+			d.PushBack(i)
+		} else {
+			d.PushFront(i)
+		}
+		if d.Len() > benchQueueLen {
+			if i%3 == 0 {
+				d.PopFront()
+			} else {
+				d.PopBack()
+			}
+		}
+	}
+}
+
+func BenchmarkDequeList(b *testing.B) {
+	l := list.New()
+	for i := range b.N {
+		if i%2 == 0 {
+			l.PushBack(i)
+		} else {
+			l.PushFront(i)
+		}
+		if l.Len() > benchQueueLen {
+			if i%3 == 0 {
+				l.Remove(l.Front())
+			} else {
+				l.Remove(l.Back())
+			}
+		}
+	}
+}
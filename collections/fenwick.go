@@ -0,0 +1,70 @@
+package collections
+
+import (
+	"fmt"
+
+	"projektarbeit-go-generics/numeric"
+)
+
+// FenwickTree, also known as a binary indexed tree, maintains prefix sums
+// over a fixed number of elements with O(log n) updates and queries.
+type FenwickTree[T numeric.Numeric] struct {
+	tree []T // 1-based; tree[i] sums the elements (i - i&-i, i]
+}
+
+// NewFenwickTree returns a tree of n elements that are all zero.
+func NewFenwickTree[T numeric.Numeric](n int) *FenwickTree[T] {
+	if n < 0 {
+		panic(fmt.Sprintf("collections: invalid FenwickTree size %d", n))
+	}
+	return &FenwickTree[T]{tree: make([]T, n+1)}
+}
+
+// NewFenwickTreeFromSlice builds a tree over the elements of s in O(n).
+func NewFenwickTreeFromSlice[T numeric.Numeric](s []T) *FenwickTree[T] {
+	ft := &FenwickTree[T]{tree: make([]T, len(s)+1)}
+	copy(ft.tree[1:], s)
+	for i := 1; i < len(ft.tree); i++ {
+		if parent := i + i&-i; parent < len(ft.tree) {
+			ft.tree[parent] += ft.tree[i]
+		}
+	}
+	return ft
+}
+
+// Len returns the number of elements.
+func (ft *FenwickTree[T]) Len() int {
+	return len(ft.tree) - 1
+}
+
+// Update adds delta to the element at index i.
+func (ft *FenwickTree[T]) Update(i int, delta T) {
+	if i < 0 || i >= ft.Len() {
+		panic(fmt.Sprintf("collections: FenwickTree index %d out of range [0, %d)", i, ft.Len()))
+	}
+	for i++; i < len(ft.tree); i += i & -i {
+		ft.tree[i] += delta
+	}
+}
+
+// PrefixSum returns the sum of the elements at indices 0 through i,
+// inclusive. PrefixSum(-1) is zero.
+func (ft *FenwickTree[T]) PrefixSum(i int) T {
+	if i < -1 || i >= ft.Len() {
+		panic(fmt.Sprintf("collections: FenwickTree index %d out of range [0, %d)", i, ft.Len()))
+	}
+	var sum T
+	for i++; i > 0; i -= i & -i {
+		sum += ft.tree[i]
+	}
+	return sum
+}
+
+// RangeSum returns the sum of the elements at indices lo through hi,
+// inclusive. The range is empty and yields zero if lo == hi+1.
+func (ft *FenwickTree[T]) RangeSum(lo, hi int) T {
+	if lo < 0 || lo > hi+1 {
+		panic(fmt.Sprintf("collections: invalid FenwickTree range [%d, %d]", lo, hi))
+	}
+	return ft.PrefixSum(hi) - ft.PrefixSum(lo-1)
+}
@@ -0,0 +1,112 @@
+package collections
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+// TestFenwickTreeAgainstNaive applies random updates and compares every
+// range sum against summing the elements directly.
+func TestFenwickTreeAgainstNaive(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	for n := 0; n <= 17; n++ {
+		elems := make([]int, n)
+		for i := range elems {
+			elems[i] = r.IntN(21) - 10
+		}
+		ft := NewFenwickTreeFromSlice(elems)
+		if ft.Len() != n {
+			t.Errorf("Len() = %d, want %d", ft.Len(), n)
+		}
+		for range n {
+			i, delta := r.IntN(n), r.IntN(21)-10
+			elems[i] += delta
+			ft.Update(i, delta)
+		}
+		for lo := 0; lo <= n; lo++ {
+			want := 0
+			for hi := lo; hi < n; hi++ {
+				want += elems[hi]
+				if got := ft.RangeSum(lo, hi); got != want {
+					t.Errorf("n=%d: RangeSum(%d, %d) = %d, want %d", n, lo, hi, got, want)
+				}
+			}
+			if got := ft.RangeSum(lo, lo-1); got != 0 {
+				t.Errorf("n=%d: empty RangeSum(%d, %d) = %d, want 0", n, lo, lo-1, got)
+			}
+		}
+	}
+}
+
+// TestFenwickTreeDefinedType uses a defined integer type. The customInt
+// structs do not satisfy numeric.Numeric, so score stands in for them.
+func TestFenwickTreeDefinedType(t *testing.T) {
+	ft := NewFenwickTree[score](4)
+	ft.Update(0, 3)
+	ft.Update(3, 4)
+	ft.Update(0, 1)
+	if got := ft.PrefixSum(2); got != 4 {
+		t.Errorf("PrefixSum(2) = %d, want 4", got)
+	}
+	if got := ft.PrefixSum(-1); got != 0 {
+		t.Errorf("PrefixSum(-1) = %d, want 0", got)
+	}
+	if got := ft.RangeSum(1, 3); got != 4 {
+		t.Errorf("RangeSum(1, 3) = %d, want 4", got)
+	}
+
+	f := NewFenwickTreeFromSlice([]float64{0.5, 1.5, 2})
+	if got := f.RangeSum(1, 2); got != 3.5 {
+		t.Errorf("RangeSum(1, 2) = %v, want 3.5", got)
+	}
+}
+
+func TestFenwickTreePanics(t *testing.T) {
+	ft := NewFenwickTree[int](3)
+	for name, f := range map[string]func(){
+		"NewFenwickTree(-1)": func() { NewFenwickTree[int](-1) },
+		"Update(3)":          func() { ft.Update(3, 1) },
+		"Update(-1)":         func() { ft.Update(-1, 1) },
+		"PrefixSum(3)":       func() { ft.PrefixSum(3) },
+		"RangeSum(2, 0)":     func() { ft.RangeSum(2, 0) },
+		"RangeSum(-1, 1)":    func() { ft.RangeSum(-1, 1) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s did not panic", name)
+				}
+			}()
+			f()
+		}()
+	}
+}
+
+const benchFenwickSize = 1024
+
+// BenchmarkFenwickTree updates one element and queries one range sum per
+// iteration.
+func BenchmarkFenwickTree(b *testing.B) {
+	ft := NewFenwickTree[int](benchFenwickSize)
+	sum := 0
+	for i := range b.N {
+		ft.Update(i%benchFenwickSize, i)
+		sum += ft.RangeSum(i%(benchFenwickSize/2), benchFenwickSize/2+i%(benchFenwickSize/2))
+	}
+}
+
+// BenchmarkNaivePrefixSum does the same with a plain slice whose prefix
+// sums are recomputed after every update.
+func BenchmarkNaivePrefixSum(b *testing.B) {
+	elems := make([]int, benchFenwickSize)
+	prefix := make([]int, benchFenwickSize+1)
+	sum := 0
+	for i := range b.N {
+		elems[i%benchFenwickSize] += i
+		for j, v := range elems {
+			prefix[j+1] = prefix[j] + v
+		}
+		lo, hi := i%(benchFenwickSize/2), benchFenwickSize/2+i%(benchFenwickSize/2)
+		sum += prefix[hi+1] - prefix[lo]
+	}
+}
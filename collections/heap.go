@@ -0,0 +1,41 @@
+package collections
+
+// heapify arranges s into a binary heap ordered by less in O(n).
+func heapify[T any](s []T, less func(T, T) bool) {
+	for i := len(s)/2 - 1; i >= 0; i-- {
+		siftDown(s, i, less)
+	}
+}
+
+// siftUp moves the element at index i towards the root until its parent is
+// no longer greater.
+func siftUp[T any](s []T, i int, less func(T, T) bool) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !less(s[i], s[parent]) {
+			break
+		}
+		s[i], s[parent] = s[parent], s[i]
+		i = parent
+	}
+}
+
+// siftDown moves the element at index i towards the leaves until both its
+// children are no longer smaller. It reports whether the element moved.
+func siftDown[T any](s []T, i int, less func(T, T) bool) bool {
+	start := i
+	for {
+		smallest := i
+		if l := 2*i + 1; l < len(s) && less(s[l], s[smallest]) {
+			smallest = l
+		}
+		if r := 2*i + 2; r < len(s) && less(s[r], s[smallest]) {
+			smallest = r
+		}
+		if smallest == i {
+			return i > start
+		}
+		s[i], s[smallest] = s[smallest], s[i]
+		i = smallest
+	}
+}
@@ -0,0 +1,104 @@
+package collections
+
+import (
+	"iter"
+	"slices"
+)
+
+// Multimap maps each key to a list of values. The zero value is not usable;
+// create one with NewMultimap.
+type Multimap[K comparable, V any] struct {
+	entries map[K][]V
+	size    int
+}
+
+// NewMultimap returns an empty Multimap.
+func NewMultimap[K comparable, V any]() *Multimap[K, V] {
+	return &Multimap[K, V]{entries: make(map[K][]V)}
+}
+
+// Add appends v to the values of key.
+func (m *Multimap[K, V]) Add(key K, v V) {
+	m.entries[key] = append(m.entries[key], v)
+	m.size++
+}
+
+// Get returns a copy of the values of key in the order they were added.
+func (m *Multimap[K, V]) Get(key K) []V {
+	return slices.Clone(m.entries[key])
+}
+
+// Delete removes key and all of its values.
+func (m *Multimap[K, V]) Delete(key K) {
+	m.size -= len(m.entries[key])
+	delete(m.entries, key)
+}
+
+// DeleteFunc removes the first value of key for which match returns true and
+// reports whether a value was removed.
+func (m *Multimap[K, V]) DeleteFunc(key K, match func(V) bool) bool {
+	values := m.entries[key]
+	for i, v := range values {
+		if !match(v) {
+			continue
+		}
+		if len(values) == 1 {
+			delete(m.entries, key)
+		} else {
+			m.entries[key] = append(values[:i:i], values[i+1:]...)
+		}
+		m.size--
+		return true
+	}
+	return false
+}
+
+// DeleteOne removes the first value of key equal to v and reports whether a
+// value was removed. It is a function rather than a method because only
+// here V needs to be comparable.
+func DeleteOne[K, V comparable](m *Multimap[K, V], key K, v V) bool {
+	return m.DeleteFunc(key, func(x V) bool { return x == v })
+}
+
+// Contains reports whether key has at least one value.
+func (m *Multimap[K, V]) Contains(key K) bool {
+	_, ok := m.entries[key]
+	return ok
+}
+
+// Keys returns the keys in unspecified order.
+func (m *Multimap[K, V]) Keys() []K {
+	keys := make([]K, 0, len(m.entries))
+	for k := range m.entries {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Len returns the total number of key-value pairs.
+func (m *Multimap[K, V]) Len() int {
+	return m.size
+}
+
+// FlatValues returns the values of all keys in a single slice.
+func (m *Multimap[K, V]) FlatValues() []V {
+	values := make([]V, 0, m.size)
+	for _, vs := range m.entries {
+		values = append(values, vs...)
+	}
+	return values
+}
+
+// All returns an iterator over all key-value pairs. All values of a key are
+// visited before moving on to the next key.
+func (m *Multimap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, vs := range m.entries {
+			for _, v := range vs {
+				if !yield(k, v) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package collections
+
+import (
+	"cmp"
+	"iter"
+)
+
+type orderedKey[K any] struct {
+	key     K
+	deleted bool
+}
+
+type orderedEntry[V any] struct {
+	value V
+	index int // Position of the key in OrderedMap.keys
+}
+
+// OrderedMap is a map that remembers the order in which keys were first
+// inserted. Deleted keys are only marked in the key slice and compacted once
+// they make up half of it, so Delete runs in amortized constant time.
+// The zero value is an empty map ready to use.
+type OrderedMap[K cmp.Ordered, V any] struct {
+	entries map[K]orderedEntry[V]
+	keys    []orderedKey[K]
+	deleted int
+}
+
+// NewOrderedMap returns an empty OrderedMap.
+func NewOrderedMap[K cmp.Ordered, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{entries: make(map[K]orderedEntry[V])}
+}
+
+// Set stores value for key. Updating an existing key keeps its position.
+func (m *OrderedMap[K, V]) Set(key K, value V) {
+	if m.entries == nil {
+		m.entries = make(map[K]orderedEntry[V])
+	}
+	if e, ok := m.entries[key]; ok {
+		e.value = value
+		m.entries[key] = e
+		return
+	}
+	m.entries[key] = orderedEntry[V]{value: value, index: len(m.keys)}
+	m.keys = append(m.keys, orderedKey[K]{key: key})
+}
+
+// Get returns the value stored for key.
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	e, ok := m.entries[key]
+	return e.value, ok
+}
+
+// Delete removes key from the map.
+func (m *OrderedMap[K, V]) Delete(key K) {
+	e, ok := m.entries[key]
+	if !ok {
+		return
+	}
+	delete(m.entries, key)
+	m.keys[e.index].deleted = true
+	m.deleted++
+	if m.deleted > len(m.keys)/2 {
+		m.compact()
+	}
+}
+
+func (m *OrderedMap[K, V]) compact() {
+	keys := make([]orderedKey[K], 0, len(m.entries))
+	for _, k := range m.keys {
+		if k.deleted {
+			continue
+		}
+		e := m.entries[k.key]
+		e.index = len(keys)
+		m.entries[k.key] = e
+		keys = append(keys, k)
+	}
+	m.keys = keys
+	m.deleted = 0
+}
+
+// Len returns the number of entries in the map.
+func (m *OrderedMap[K, V]) Len() int {
+	return len(m.entries)
+}
+
+// Keys returns the keys in insertion order.
+func (m *OrderedMap[K, V]) Keys() []K {
+	keys := make([]K, 0, len(m.entries))
+	for k := range m.All() {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Values returns the values in insertion order of their keys.
+func (m *OrderedMap[K, V]) Values() []V {
+	values := make([]V, 0, len(m.entries))
+	for _, v := range m.All() {
+		values = append(values, v)
+	}
+	return values
+}
+
+// All returns an iterator over the entries in insertion order.
+func (m *OrderedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, k := range m.keys {
+			if k.deleted {
+				continue
+			}
+			if !yield(k.key, m.entries[k.key].value) {
+				return
+			}
+		}
+	}
+}
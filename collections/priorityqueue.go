@@ -0,0 +1,30 @@
+package collections
+
+import "cmp"
+
+// PriorityQueue is a binary heap ordered by a comparator. Pop always returns
+// the element e for which less(e, x) holds for every other element x. It
+// adds Update to the methods of Heap, which it embeds.
+type PriorityQueue[T any] struct {
+	Heap[T]
+}
+
+// NewPriorityQueue returns an empty priority queue ordered by less.
+func NewPriorityQueue[T any](less func(T, T) bool) *PriorityQueue[T] {
+	return &PriorityQueue[T]{Heap[T]{less: less}}
+}
+
+// PriorityQueueOf returns a min-priority queue containing elems, ordered by
+// cmp.Less.
+func PriorityQueueOf[T cmp.Ordered](elems ...T) *PriorityQueue[T] {
+	return &PriorityQueue[T]{*NewMinHeap(elems...)}
+}
+
+// Update replaces the element at heap index i with newVal and restores the
+// heap property.
+func (pq *PriorityQueue[T]) Update(i int, newVal T) {
+	pq.elems[i] = newVal
+	if !siftDown(pq.elems, i, pq.less) {
+		siftUp(pq.elems, i, pq.less)
+	}
+}
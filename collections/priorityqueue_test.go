@@ -0,0 +1,48 @@
+package collections
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPriorityQueue(t *testing.T) {
+	pq := NewPriorityQueue(customIntGreater)
+	for _, v := range []int{3, 9, 1, 7} {
+		pq.Push(customInt[int]{v})
+	}
+	if v, ok := pq.Peek(); !ok || v.value != 9 {
+		t.Errorf("Peek() = %v, %v, want {9}, true", v, ok)
+	}
+	var got []int
+	for pq.Len() > 0 {
+		v, _ := pq.Pop()
+		got = append(got, v.value)
+	}
+	if want := []int{9, 7, 3, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("pops = %v, want %v", got, want)
+	}
+	if _, ok := pq.Pop(); ok {
+		t.Error("Pop() of an empty queue reported a value")
+	}
+}
+
+func TestPriorityQueueUpdate(t *testing.T) {
+	pq := PriorityQueueOf(5, 3, 8, 1)
+	// Raise the minimum above all others, then lower a leaf below all.
+	pq.Update(0, 10)
+	checkHeap(t, &pq.Heap)
+	last := pq.elems[pq.Len()-1]
+	pq.Update(pq.Len()-1, 0)
+	checkHeap(t, &pq.Heap)
+
+	want := []int{0, 3, 5, 8, 10}
+	for i, v := range want {
+		if v == last {
+			want = append(want[:i], want[i+1:]...)
+			break
+		}
+	}
+	if got := drain(&pq.Heap); !reflect.DeepEqual(got, want) {
+		t.Errorf("pops after Update = %v, want %v", got, want)
+	}
+}
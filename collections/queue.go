@@ -0,0 +1,74 @@
+package collections
+
+import "iter"
+
+const minQueueCap = 8
+
+// Queue is a first-in-first-out collection backed by a ring buffer whose
+// capacity is always a power of two. The zero value is an empty queue ready
+// to use. A Queue is not safe for concurrent use.
+type Queue[T any] struct {
+	buf  []T
+	head int
+	len  int
+}
+
+// Enqueue adds v to the back of the queue.
+func (q *Queue[T]) Enqueue(v T) {
+	if q.len == len(q.buf) {
+		q.grow()
+	}
+	q.buf[(q.head+q.len)&(len(q.buf)-1)] = v
+	q.len++
+}
+
+// Dequeue removes and returns the front element. It returns the zero value
+// and false if the queue is empty.
+func (q *Queue[T]) Dequeue() (T, bool) {
+	var zero T
+	if q.len == 0 {
+		return zero, false
+	}
+	v := q.buf[q.head]
+	q.buf[q.head] = zero
+	q.head = (q.head + 1) & (len(q.buf) - 1)
+	q.len--
+	return v, true
+}
+
+// Peek returns the front element without removing it. It returns the zero
+// value and false if the queue is empty.
+func (q *Queue[T]) Peek() (T, bool) {
+	if q.len == 0 {
+		var zero T
+		return zero, false
+	}
+	return q.buf[q.head], true
+}
+
+// Len returns the number of elements in the queue.
+func (q *Queue[T]) Len() int {
+	return q.len
+}
+
+// All returns an iterator over the elements from front to back.
+func (q *Queue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := range q.len {
+			if !yield(q.buf[(q.head+i)&(len(q.buf)-1)]) {
+				return
+			}
+		}
+	}
+}
+
+// grow doubles the capacity and moves the elements into logical order
+// starting at index 0.
+func (q *Queue[T]) grow() {
+	newCap := max(2*len(q.buf), minQueueCap)
+	buf := make([]T, newCap)
+	n := copy(buf, q.buf[q.head:])
+	copy(buf[n:], q.buf[:q.head])
+	q.buf = buf
+	q.head = 0
+}
@@ -0,0 +1,104 @@
+package collections
+
+import (
+	"container/list"
+	"slices"
+	"testing"
+)
+
+func TestQueueFIFO(t *testing.T) {
+	var q Queue[int]
+	if _, ok := q.Dequeue(); ok {
+		t.Error("Dequeue on an empty queue succeeded")
+	}
+	if _, ok := q.Peek(); ok {
+		t.Error("Peek on an empty queue succeeded")
+	}
+	for i := range 5 {
+		q.Enqueue(i)
+	}
+	if v, ok := q.Peek(); v != 0 || !ok {
+		t.Errorf("Peek() = %d, %v, want 0, true", v, ok)
+	}
+	for i := range 5 {
+		if v, ok := q.Dequeue(); v != i || !ok {
+			t.Errorf("Dequeue() = %d, %v, want %d, true", v, ok, i)
+		}
+	}
+	if q.Len() != 0 {
+		t.Errorf("Len() = %d, want 0", q.Len())
+	}
+}
+
+// TestQueueGrowWrapped fills a queue whose elements wrap around the end of
+// the ring, so that growing has to restore the logical order.
+func TestQueueGrowWrapped(t *testing.T) {
+	var q Queue[int]
+	for i := range minQueueCap {
+		q.Enqueue(i)
+	}
+	for range 5 {
+		q.Dequeue()
+	}
+	next := minQueueCap
+	for range 2 * minQueueCap {
+		q.Enqueue(next)
+		next++
+	}
+	if len(q.buf) != 4*minQueueCap {
+		t.Errorf("capacity = %d, want %d", len(q.buf), 4*minQueueCap)
+	}
+	var want []int
+	for i := 5; i < next; i++ {
+		want = append(want, i)
+	}
+	if got := slices.Collect(q.All()); !slices.Equal(got, want) {
+		t.Errorf("All() = %v, want %v", got, want)
+	}
+	for _, w := range want {
+		if v, _ := q.Dequeue(); v != w {
+			t.Fatalf("Dequeue() = %d, want %d", v, w)
+		}
+	}
+}
+
+func TestQueueAllBreak(t *testing.T) {
+	var q Queue[string]
+	q.Enqueue("a")
+	q.Enqueue("b")
+	q.Enqueue("c")
+	var got []string
+	for v := range q.All() {
+		got = append(got, v)
+		if v == "b" {
+			break
+		}
+	}
+	if want := []string{"a", "b"}; !slices.Equal(got, want) {
+		t.Errorf("range over All() = %v, want %v", got, want)
+	}
+}
+
+// The benchmarks keep up to 1024 elements queued, so both queues reach a
+// steady state after the first iterations.
+const benchQueueLen = 1024
+
+func BenchmarkQueue(b *testing.B) {
+	var q Queue[int]
+	for i := range b.N {
+		q.Enqueue(i)
+		if q.Len() > benchQueueLen {
+			q.Dequeue()
+		}
+	}
+}
+
+func BenchmarkList(b *testing.B) {
+	l := list.New()
+	for i := range b.N {
+		l.PushBack(i)
+		if l.Len() > benchQueueLen {
+			l.Remove(l.Front())
+		}
+	}
+}
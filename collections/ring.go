@@ -0,0 +1,129 @@
+package collections
+
+import (
+	"fmt"
+	"iter"
+)
+
+// Ring is a circular buffer with a fixed capacity. Unlike the other
+// containers its zero value is not usable; every method panics unless the
+// ring was created with NewRing.
+type Ring[T any] struct {
+	buf  []T
+	head int
+	len  int
+}
+
+// NewRing returns an empty ring holding at most capacity elements.
+func NewRing[T any](capacity int) *Ring[T] {
+	if capacity <= 0 {
+		panic(fmt.Sprintf("collections: invalid Ring capacity %d", capacity))
+	}
+	return &Ring[T]{buf: make([]T, capacity)}
+}
+
+func (r *Ring[T]) mustInit() {
+	if r.buf == nil {
+		panic("collections: Ring used without NewRing")
+	}
+}
+
+// Push appends v at the back. It returns false and leaves the ring
+// unchanged if it is full.
+func (r *Ring[T]) Push(v T) bool {
+	r.mustInit()
+	if r.len == len(r.buf) {
+		return false
+	}
+	r.buf[(r.head+r.len)%len(r.buf)] = v
+	r.len++
+	return true
+}
+
+// PushOverwrite appends v at the back. If the ring is full, the oldest
+// element is overwritten and returned; otherwise the zero value is returned.
+func (r *Ring[T]) PushOverwrite(v T) T {
+	r.mustInit()
+	var old T
+	if r.len == len(r.buf) {
+		old, _ = r.Pop()
+	}
+	r.Push(v)
+	return old
+}
+
+// Pop removes and returns the oldest element. It returns the zero value and
+// false if the ring is empty.
+func (r *Ring[T]) Pop() (T, bool) {
+	r.mustInit()
+	var zero T
+	if r.len == 0 {
+		return zero, false
+	}
+	v := r.buf[r.head]
+	r.buf[r.head] = zero
+	r.head = (r.head + 1) % len(r.buf)
+	r.len--
+	return v, true
+}
+
+// PopBack removes and returns the newest element. It returns the zero value
+// and false if the ring is empty.
+func (r *Ring[T]) PopBack() (T, bool) {
+	r.mustInit()
+	var zero T
+	if r.len == 0 {
+		return zero, false
+	}
+	i := (r.head + r.len - 1) % len(r.buf)
+	v := r.buf[i]
+	r.buf[i] = zero
+	r.len--
+	return v, true
+}
+
+// Peek returns the oldest element without removing it.
+func (r *Ring[T]) Peek() (T, bool) {
+	r.mustInit()
+	if r.len == 0 {
+		var zero T
+		return zero, false
+	}
+	return r.buf[r.head], true
+}
+
+// Len returns the number of elements in the ring.
+func (r *Ring[T]) Len() int {
+	r.mustInit()
+	return r.len
+}
+
+// Cap returns the fixed capacity of the ring.
+func (r *Ring[T]) Cap() int {
+	r.mustInit()
+	return len(r.buf)
+}
+
+// IsFull reports whether the ring holds Cap elements.
+func (r *Ring[T]) IsFull() bool {
+	r.mustInit()
+	return r.len == len(r.buf)
+}
+
+// IsEmpty reports whether the ring holds no elements.
+func (r *Ring[T]) IsEmpty() bool {
+	r.mustInit()
+	return r.len == 0
+}
+
+// All yields the elements from oldest to newest without removing them.
+func (r *Ring[T]) All() iter.Seq[T] {
+	r.mustInit()
+	return func(yield func(T) bool) {
+		for i := range r.len {
+			if !yield(r.buf[(r.head+i)%len(r.buf)]) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package collections
+
+import (
+	"container/ring"
+	"slices"
+	"testing"
+)
+
+func TestRingPushPop(t *testing.T) {
+	r := NewRing[int](3)
+	if !r.IsEmpty() || r.IsFull() || r.Cap() != 3 {
+		t.Errorf("new ring: IsEmpty, IsFull, Cap = %v, %v, %d", r.IsEmpty(), r.IsFull(), r.Cap())
+	}
+	for i := range 3 {
+		if !r.Push(i) {
+			t.Errorf("Push(%d) = false, want true", i)
+		}
+	}
+	if r.Push(3) {
+		t.Error("Push on a full ring = true, want false")
+	}
+	if !r.IsFull() || r.Len() != 3 {
+		t.Errorf("IsFull, Len = %v, %d, want true, 3", r.IsFull(), r.Len())
+	}
+	if v, ok := r.Peek(); v != 0 || !ok {
+		t.Errorf("Peek() = %d, %v, want 0, true", v, ok)
+	}
+	// Wrap around the end of the buffer.
+	r.Pop()
+	r.Push(3)
+	if got := slices.Collect(r.All()); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("All() = %v, want [1 2 3]", got)
+	}
+	if v, ok := r.PopBack(); v != 3 || !ok {
+		t.Errorf("PopBack() = %d, %v, want 3, true", v, ok)
+	}
+	for _, want := range []int{1, 2} {
+		if v, ok := r.Pop(); v != want || !ok {
+			t.Errorf("Pop() = %d, %v, want %d, true", v, ok, want)
+		}
+	}
+	if _, ok := r.Pop(); ok {
+		t.Error("Pop on an empty ring succeeded")
+	}
+	if _, ok := r.PopBack(); ok {
+		t.Error("PopBack on an empty ring succeeded")
+	}
+}
+
+func TestRingPushOverwrite(t *testing.T) {
+	r := NewRing[string](2)
+	for _, tt := range []struct{ push, old string }{{"a", ""}, {"b", ""}, {"c", "a"}, {"d", "b"}} {
+		if old := r.PushOverwrite(tt.push); old != tt.old {
+			t.Errorf("PushOverwrite(%q) = %q, want %q", tt.push, old, tt.old)
+		}
+	}
+	if got := slices.Collect(r.All()); !slices.Equal(got, []string{"c", "d"}) {
+		t.Errorf("All() = %v, want [c d]", got)
+	}
+}
+
+func TestRingZeroValuePanics(t *testing.T) {
+	var r Ring[int]
+	for name, f := range map[string]func(){
+		"Push": func() { r.Push(1) },
+		"Pop":  func() { r.Pop() },
+		"Len":  func() { r.Len() },
+		"All":  func() { r.All() },
+	} {
+		func() {
+			defer func() {
+				if got := recover(); got != "collections: Ring used without NewRing" {
+					t.Errorf("%s on the zero Ring panicked with %v", name, got)
+				}
+			}()
+			f()
+		}()
+	}
+}
+
+func TestNewRingInvalidCapacity(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("NewRing(0) did not panic")
+		}
+	}()
+	NewRing[int](0)
+}
+
+const benchRingCap = 64
+
+func BenchmarkRing(b *testing.B) {
+	r := NewRing[int](benchRingCap)
+	sum := 0
+	for i := range b.N {
+		sum += r.PushOverwrite(i)
+	}
+}
+
+// BenchmarkContainerRing does the same with container/ring, which stores
+// interface values and needs a type assertion on every read.
+func BenchmarkContainerRing(b *testing.B) {
+	r := ring.New(benchRingCap)
+	sum := 0
+	for i := range b.N {
+		if v, ok := r.Value.(int); ok {
+			sum += v
+		}
+		r.Value = i
+		r = r.Next()
+	}
+}
@@ -0,0 +1,62 @@
+package collections
+
+import "fmt"
+
+// SegmentTree answers range queries over a fixed number of elements in
+// O(log n). combine must be associative and identity must be its neutral
+// element; combine does not need to be commutative.
+type SegmentTree[T any] struct {
+	n        int
+	tree     []T // Leaves live at tree[n:], tree[i] combines tree[2i] and tree[2i+1]
+	combine  func(T, T) T
+	identity T
+}
+
+// NewSegmentTree builds a segment tree over data in O(n).
+func NewSegmentTree[T any](data []T, combine func(T, T) T, identity T) *SegmentTree[T] {
+	n := len(data)
+	st := &SegmentTree[T]{n: n, tree: make([]T, 2*n), combine: combine, identity: identity}
+	copy(st.tree[n:], data)
+	for i := n - 1; i > 0; i-- {
+		st.tree[i] = combine(st.tree[2*i], st.tree[2*i+1])
+	}
+	return st
+}
+
+// Len returns the number of elements.
+func (st *SegmentTree[T]) Len() int {
+	return st.n
+}
+
+// Update sets the element at index i to v.
+func (st *SegmentTree[T]) Update(i int, v T) {
+	if i < 0 || i >= st.n {
+		panic(fmt.Sprintf("collections: SegmentTree index %d out of range [0, %d)", i, st.n))
+	}
+	i += st.n
+	st.tree[i] = v
+	for i > 1 {
+		i /= 2
+		st.tree[i] = st.combine(st.tree[2*i], st.tree[2*i+1])
+	}
+}
+
+// Query combines the elements in the half-open range [l, r) from left to
+// right. An empty range yields identity.
+func (st *SegmentTree[T]) Query(l, r int) T {
+	if l < 0 || r > st.n || l > r {
+		panic(fmt.Sprintf("collections: SegmentTree range [%d, %d) out of range [0, %d)", l, r, st.n))
+	}
+	left, right := st.identity, st.identity
+	for l, r = l+st.n, r+st.n; l < r; l, r = l/2, r/2 {
+		if l%2 == 1 {
+			left = st.combine(left, st.tree[l])
+			l++
+		}
+		if r%2 == 1 {
+			r--
+			right = st.combine(st.tree[r], right)
+		}
+	}
+	return st.combine(left, right)
+}
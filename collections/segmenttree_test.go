@@ -0,0 +1,92 @@
+package collections
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func concat(a, b string) string { return a + b }
+
+// TestSegmentTreeConcat compares every range of a non-commutative
+// concatenation against the naive result, for sizes that are and are not
+// powers of two.
+func TestSegmentTreeConcat(t *testing.T) {
+	letters := strings.Split("abcdefghij", "")
+	for n := 0; n <= len(letters); n++ {
+		st := NewSegmentTree(letters[:n], concat, "")
+		if st.Len() != n {
+			t.Errorf("Len() = %d, want %d", st.Len(), n)
+		}
+		for l := 0; l <= n; l++ {
+			for r := l; r <= n; r++ {
+				if got, want := st.Query(l, r), strings.Join(letters[l:r], ""); got != want {
+					t.Errorf("n=%d: Query(%d, %d) = %q, want %q", n, l, r, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestSegmentTreeSum(t *testing.T) {
+	add := func(a, b customInt[int]) customInt[int] { return customInt[int]{a.value + b.value} }
+	st := NewSegmentTree([]customInt[int]{{1}, {2}, {3}, {4}, {5}}, add, customInt[int]{})
+	if got := st.Query(0, 5); got.value != 15 {
+		t.Errorf("full range sum = %d, want 15", got.value)
+	}
+	if got := st.Query(2, 3); got.value != 3 {
+		t.Errorf("single element sum = %d, want 3", got.value)
+	}
+	st.Update(2, customInt[int]{10})
+	if got := st.Query(1, 4); got.value != 16 {
+		t.Errorf("sum after Update = %d, want 16", got.value)
+	}
+	if got := st.Query(3, 3); got.value != 0 {
+		t.Errorf("empty range sum = %d, want the identity 0", got.value)
+	}
+}
+
+func TestSegmentTreeMin(t *testing.T) {
+	st := NewSegmentTree([]float64{3.5, -1, 2, 8, 0.5}, math.Min, math.Inf(1))
+	if got := st.Query(0, 5); got != -1 {
+		t.Errorf("full range min = %v, want -1", got)
+	}
+	if got := st.Query(2, 5); got != 0.5 {
+		t.Errorf("Query(2, 5) = %v, want 0.5", got)
+	}
+	st.Update(4, 9)
+	st.Update(1, 7)
+	if got := st.Query(0, 5); got != 2 {
+		t.Errorf("min after Update = %v, want 2", got)
+	}
+	if got := st.Query(4, 5); got != 9 {
+		t.Errorf("single element min = %v, want 9", got)
+	}
+}
+
+func TestSegmentTreeUpdateConcat(t *testing.T) {
+	st := NewSegmentTree([]string{"a", "b", "c"}, concat, "")
+	st.Update(0, "x")
+	st.Update(2, "z")
+	if got := st.Query(0, 3); got != "xbz" {
+		t.Errorf("Query(0, 3) after Update = %q, want \"xbz\"", got)
+	}
+}
+
+func TestSegmentTreePanics(t *testing.T) {
+	st := NewSegmentTree([]int{1, 2}, func(a, b int) int { return a + b }, 0)
+	for name, f := range map[string]func(){
+		"Update out of range": func() { st.Update(2, 0) },
+		"Query past the end":  func() { st.Query(0, 3) },
+		"Query reversed":      func() { st.Query(2, 1) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s did not panic", name)
+				}
+			}()
+			f()
+		}()
+	}
+}
@@ -0,0 +1,120 @@
+// Package collections provides generic container types.
+package collections
+
+import "sort"
+
+// Set is an unordered collection of unique elements. Sets are immutable:
+// every method that changes the contents returns a new Set and leaves the
+// receiver untouched, so a Set can be copied and shared freely.
+type Set[T comparable] struct {
+	elems map[T]struct{}
+}
+
+// NewSet returns a set containing elems.
+func NewSet[T comparable](elems ...T) Set[T] {
+	s := Set[T]{elems: make(map[T]struct{}, len(elems))}
+	for _, e := range elems {
+		s.elems[e] = struct{}{}
+	}
+	return s
+}
+
+func (s Set[T]) clone(extra int) Set[T] {
+	c := Set[T]{elems: make(map[T]struct{}, len(s.elems)+extra)}
+	for e := range s.elems {
+		c.elems[e] = struct{}{}
+	}
+	return c
+}
+
+// Add returns a new set containing the elements of s and elems.
+func (s Set[T]) Add(elems ...T) Set[T] {
+	c := s.clone(len(elems))
+	for _, e := range elems {
+		c.elems[e] = struct{}{}
+	}
+	return c
+}
+
+// Remove returns a new set containing the elements of s except elems.
+func (s Set[T]) Remove(elems ...T) Set[T] {
+	c := s.clone(0)
+	for _, e := range elems {
+		delete(c.elems, e)
+	}
+	return c
+}
+
+// Contains reports whether e is an element of s.
+func (s Set[T]) Contains(e T) bool {
+	_, ok := s.elems[e]
+	return ok
+}
+
+// Len returns the number of elements in s.
+func (s Set[T]) Len() int {
+	return len(s.elems)
+}
+
+// Union returns a new set containing the elements of s and other.
+func (s Set[T]) Union(other Set[T]) Set[T] {
+	c := s.clone(other.Len())
+	for e := range other.elems {
+		c.elems[e] = struct{}{}
+	}
+	return c
+}
+
+// Intersection returns a new set containing the elements present in both s
+// and other.
+func (s Set[T]) Intersection(other Set[T]) Set[T] {
+	small, large := s, other
+	if small.Len() > large.Len() {
+		small, large = large, small
+	}
+	c := NewSet[T]()
+	for e := range small.elems {
+		if large.Contains(e) {
+			c.elems[e] = struct{}{}
+		}
+	}
+	return c
+}
+
+// Difference returns a new set containing the elements of s that are not in
+// other.
+func (s Set[T]) Difference(other Set[T]) Set[T] {
+	c := NewSet[T]()
+	for e := range s.elems {
+		if !other.Contains(e) {
+			c.elems[e] = struct{}{}
+		}
+	}
+	return c
+}
+
+// IsSubset reports whether every element of s is also in other.
+func (s Set[T]) IsSubset(other Set[T]) bool {
+	if s.Len() > other.Len() {
+		return false
+	}
+	for e := range s.elems {
+		if !other.Contains(e) {
+			return false
+		}
+	}
+	return true
+}
+
+// ToSlice returns the elements of s. Without less the order is unspecified;
+// if less is given, the result is sorted by it.
+func (s Set[T]) ToSlice(less ...func(T, T) bool) []T {
+	result := make([]T, 0, len(s.elems))
+	for e := range s.elems {
+		result = append(result, e)
+	}
+	if len(less) > 0 && less[0] != nil {
+		sort.Slice(result, func(i, j int) bool { return less[0](result[i], result[j]) })
+	}
+	return result
+}
@@ -0,0 +1,104 @@
+package collections
+
+import (
+	"reflect"
+	"testing"
+)
+
+type customInt[T int | int8 | int16 | int32 | int64] struct {
+	value T
+}
+
+func intLess(a, b int) bool { return a < b }
+
+func TestSetBasics(t *testing.T) {
+	s := NewSet(3, 1, 2, 3)
+	if s.Len() != 3 {
+		t.Errorf("Len() = %d, want 3", s.Len())
+	}
+	if !s.Contains(2) || s.Contains(4) {
+		t.Errorf("Contains(2), Contains(4) = %v, %v, want true, false", s.Contains(2), s.Contains(4))
+	}
+	if got, want := s.ToSlice(intLess), []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ToSlice(less) = %v, want %v", got, want)
+	}
+	if got := s.ToSlice(); len(got) != 3 {
+		t.Errorf("ToSlice() = %v, want 3 elements", got)
+	}
+}
+
+func TestSetImmutable(t *testing.T) {
+	s := NewSet(1, 2)
+	added := s.Add(3)
+	removed := s.Remove(1)
+	if got, want := s.ToSlice(intLess), []int{1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("original set = %v after Add and Remove, want %v", got, want)
+	}
+	if got, want := added.ToSlice(intLess), []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Add(3) = %v, want %v", got, want)
+	}
+	if got, want := removed.ToSlice(intLess), []int{2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Remove(1) = %v, want %v", got, want)
+	}
+}
+
+func TestSetZeroValue(t *testing.T) {
+	var s Set[string]
+	if s.Len() != 0 || s.Contains("a") {
+		t.Error("zero Set is not empty")
+	}
+	if got := s.Add("a"); !got.Contains("a") {
+		t.Error("Add on the zero Set lost the element")
+	}
+}
+
+func TestSetOperations(t *testing.T) {
+	a := NewSet(1, 2, 3, 4)
+	b := NewSet(3, 4, 5)
+	for _, tt := range []struct {
+		name string
+		got  Set[int]
+		want []int
+	}{
+		{"Union", a.Union(b), []int{1, 2, 3, 4, 5}},
+		{"Intersection", a.Intersection(b), []int{3, 4}},
+		{"Intersection reversed", b.Intersection(a), []int{3, 4}},
+		{"Difference", a.Difference(b), []int{1, 2}},
+		{"Difference reversed", b.Difference(a), []int{5}},
+	} {
+		if got := tt.got.ToSlice(intLess); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSetIsSubset(t *testing.T) {
+	a := NewSet(1, 2)
+	for _, tt := range []struct {
+		other Set[int]
+		want  bool
+	}{
+		{NewSet(1, 2, 3), true},
+		{NewSet(1, 2), true},
+		{NewSet(1, 3), false},
+		{NewSet(1), false},
+	} {
+		if got := a.IsSubset(tt.other); got != tt.want {
+			t.Errorf("%v.IsSubset(%v) = %v, want %v", a.ToSlice(intLess), tt.other.ToSlice(intLess), got, tt.want)
+		}
+	}
+	if !NewSet[int]().IsSubset(a) {
+		t.Error("empty set is not a subset")
+	}
+}
+
+func TestSetCustomInt(t *testing.T) {
+	s := NewSet(customInt[int8]{1}, customInt[int8]{2}).Add(customInt[int8]{1})
+	if s.Len() != 2 || !s.Contains(customInt[int8]{2}) {
+		t.Errorf("Set[customInt[int8]] has %d elements, want 2", s.Len())
+	}
+	wide := NewSet(customInt[int64]{1 << 40})
+	if !wide.Union(NewSet(customInt[int64]{7})).Contains(customInt[int64]{1 << 40}) {
+		t.Error("Union of Set[customInt[int64]] lost an element")
+	}
+}
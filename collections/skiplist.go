@@ -0,0 +1,165 @@
+package collections
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"math/rand/v2"
+)
+
+type skipNode[K cmp.Ordered, V any] struct {
+	key  K
+	val  V
+	next []*skipNode[K, V]
+}
+
+// SkipList is a sorted map with expected O(log n) lookups, insertions and
+// deletions. Create one with NewSkipList.
+type SkipList[K cmp.Ordered, V any] struct {
+	head  skipNode[K, V] // sentinel; head.next[i] is the first node on level i
+	level int            // number of levels in use
+	p     float64
+	rand  *rand.Rand
+	len   int
+}
+
+// NewSkipList returns an empty skip list with at most maxLevel levels in
+// which a node reaches the next level with probability p. Levels are drawn
+// from src, so a seeded source yields a reproducible structure. Choose
+// maxLevel around log(n)/log(1/p) for n expected elements. NewSkipList
+// panics if maxLevel is not positive or p is not in (0, 1).
+func NewSkipList[K cmp.Ordered, V any](maxLevel int, p float64, src rand.Source) *SkipList[K, V] {
+	if maxLevel <= 0 {
+		panic(fmt.Sprintf("collections: invalid SkipList max level %d", maxLevel))
+	}
+	if !(p > 0 && p < 1) {
+		panic(fmt.Sprintf("collections: invalid SkipList probability %v", p))
+	}
+	return &SkipList[K, V]{
+		head:  skipNode[K, V]{next: make([]*skipNode[K, V], maxLevel)},
+		level: 1,
+		p:     p,
+		rand:  rand.New(src),
+	}
+}
+
+func (s *SkipList[K, V]) randomLevel() int {
+	level := 1
+	for level < len(s.head.next) && s.rand.Float64() < s.p {
+		level++
+	}
+	return level
+}
+
+// findPrev fills prev with the last node before key on every level in use
+// and returns the first node with a key not less than key.
+func (s *SkipList[K, V]) findPrev(key K, prev []*skipNode[K, V]) *skipNode[K, V] {
+	n := &s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for n.next[i] != nil && n.next[i].key < key {
+			n = n.next[i]
+		}
+		if prev != nil {
+			prev[i] = n
+		}
+	}
+	return n.next[0]
+}
+
+// Set stores val for key.
+func (s *SkipList[K, V]) Set(key K, val V) {
+	prev := make([]*skipNode[K, V], len(s.head.next))
+	if n := s.findPrev(key, prev); n != nil && n.key == key {
+		n.val = val
+		return
+	}
+	level := s.randomLevel()
+	for i := s.level; i < level; i++ {
+		prev[i] = &s.head
+	}
+	s.level = max(s.level, level)
+	n := &skipNode[K, V]{key: key, val: val, next: make([]*skipNode[K, V], level)}
+	for i := range level {
+		n.next[i] = prev[i].next[i]
+		prev[i].next[i] = n
+	}
+	s.len++
+}
+
+// Get returns the value stored for key.
+func (s *SkipList[K, V]) Get(key K) (V, bool) {
+	if n := s.findPrev(key, nil); n != nil && n.key == key {
+		return n.val, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Delete removes key and reports whether it was present.
+func (s *SkipList[K, V]) Delete(key K) bool {
+	prev := make([]*skipNode[K, V], len(s.head.next))
+	n := s.findPrev(key, prev)
+	if n == nil || n.key != key {
+		return false
+	}
+	for i := range n.next {
+		prev[i].next[i] = n.next[i]
+	}
+	for s.level > 1 && s.head.next[s.level-1] == nil {
+		s.level--
+	}
+	s.len--
+	return true
+}
+
+// Min returns the smallest key and its value.
+func (s *SkipList[K, V]) Min() (K, V, bool) {
+	if n := s.head.next[0]; n != nil {
+		return n.key, n.val, true
+	}
+	var zeroK K
+	var zeroV V
+	return zeroK, zeroV, false
+}
+
+// Max returns the largest key and its value.
+func (s *SkipList[K, V]) Max() (K, V, bool) {
+	n := &s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for n.next[i] != nil {
+			n = n.next[i]
+		}
+	}
+	if n == &s.head {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+	return n.key, n.val, true
+}
+
+// Range calls visit for every key in the half-open interval [lo, hi) in
+// ascending order until visit returns false.
+func (s *SkipList[K, V]) Range(lo, hi K, visit func(K, V) bool) {
+	for n := s.findPrev(lo, nil); n != nil && n.key < hi; n = n.next[0] {
+		if !visit(n.key, n.val) {
+			return
+		}
+	}
+}
+
+// All yields all keys and values in ascending key order.
+func (s *SkipList[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := s.head.next[0]; n != nil; n = n.next[0] {
+			if !yield(n.key, n.val) {
+				return
+			}
+		}
+	}
+}
+
+// Len returns the number of keys.
+func (s *SkipList[K, V]) Len() int {
+	return s.len
+}
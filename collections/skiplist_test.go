@@ -0,0 +1,208 @@
+package collections
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"sort"
+	"testing"
+)
+
+func newTestSkipList[K int | score]() *SkipList[K, string] {
+	return NewSkipList[K, string](16, 0.5, rand.NewPCG(1, 2))
+}
+
+// score is a defined integer type. customInt is a struct and therefore not
+// cmp.Ordered, so the tests key by a defined type instead.
+type score int
+
+func skipListKeys[K int | score, V any](s *SkipList[K, V]) []K {
+	var keys []K
+	for k := range s.All() {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func TestSkipListSetGetDelete(t *testing.T) {
+	s := newTestSkipList[int]()
+	for _, k := range []int{5, 1, 9, 3, 7} {
+		s.Set(k, "v")
+	}
+	s.Set(3, "three")
+	if s.Len() != 5 {
+		t.Errorf("Len() = %d, want 5", s.Len())
+	}
+	if got, ok := s.Get(3); !ok || got != "three" {
+		t.Errorf("Get(3) = %q, %v, want three, true", got, ok)
+	}
+	if _, ok := s.Get(4); ok {
+		t.Error("Get(4) found a missing key")
+	}
+	if !s.Delete(5) || s.Delete(5) {
+		t.Error("Delete(5) twice did not return true, false")
+	}
+	if got, want := skipListKeys(s), []int{1, 3, 7, 9}; !reflect.DeepEqual(got, want) {
+		t.Errorf("keys = %v, want %v", got, want)
+	}
+}
+
+func TestSkipListMinMax(t *testing.T) {
+	s := newTestSkipList[score]()
+	if _, _, ok := s.Min(); ok {
+		t.Error("Min() of an empty list reported a key")
+	}
+	if _, _, ok := s.Max(); ok {
+		t.Error("Max() of an empty list reported a key")
+	}
+	for _, k := range []score{40, -3, 12} {
+		s.Set(k, "v")
+	}
+	if k, _, _ := s.Min(); k != -3 {
+		t.Errorf("Min() = %d, want -3", k)
+	}
+	if k, _, _ := s.Max(); k != 40 {
+		t.Errorf("Max() = %d, want 40", k)
+	}
+}
+
+func TestSkipListRange(t *testing.T) {
+	s := newTestSkipList[int]()
+	for i := range 10 {
+		s.Set(i*10, "v")
+	}
+	var got []int
+	s.Range(15, 60, func(k int, _ string) bool {
+		got = append(got, k)
+		return true
+	})
+	if want := []int{20, 30, 40, 50}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Range(15, 60) = %v, want %v", got, want)
+	}
+	got = nil
+	s.Range(0, 100, func(k int, _ string) bool {
+		got = append(got, k)
+		return len(got) < 2
+	})
+	if want := []int{0, 10}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Range stopped early = %v, want %v", got, want)
+	}
+}
+
+// TestSkipListRandomized mirrors random operations on a map and checks
+// that the list stays sorted and agrees with it.
+func TestSkipListRandomized(t *testing.T) {
+	s := newTestSkipList[int]()
+	want := map[int]string{}
+	r := rand.New(rand.NewPCG(3, 4))
+	for range 2000 {
+		k := r.IntN(200)
+		if r.IntN(3) == 0 {
+			_, present := want[k]
+			if got := s.Delete(k); got != present {
+				t.Fatalf("Delete(%d) = %v, want %v", k, got, present)
+			}
+			delete(want, k)
+		} else {
+			s.Set(k, "v")
+			want[k] = "v"
+		}
+	}
+	keys := skipListKeys(s)
+	if len(keys) != len(want) || s.Len() != len(want) {
+		t.Fatalf("list has %d keys and Len() %d, want %d", len(keys), s.Len(), len(want))
+	}
+	if !slices.IsSorted(keys) {
+		t.Errorf("keys are not sorted: %v", keys)
+	}
+	for _, k := range keys {
+		if _, ok := want[k]; !ok {
+			t.Errorf("list contains deleted key %d", k)
+		}
+	}
+}
+
+// TestSkipListReproducible checks that the same seed yields the same
+// levels.
+func TestSkipListReproducible(t *testing.T) {
+	levels := func() []int {
+		s := newTestSkipList[int]()
+		var ls []int
+		for range 50 {
+			ls = append(ls, s.randomLevel())
+		}
+		return ls
+	}
+	if a, b := levels(), levels(); !reflect.DeepEqual(a, b) {
+		t.Errorf("levels differ for the same seed:\n%v\n%v", a, b)
+	}
+}
+
+func TestNewSkipListPanics(t *testing.T) {
+	for _, tc := range []struct {
+		maxLevel int
+		p        float64
+	}{{0, 0.5}, {4, 0}, {4, 1}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewSkipList(%d, %v) did not panic", tc.maxLevel, tc.p)
+				}
+			}()
+			NewSkipList[int, int](tc.maxLevel, tc.p, rand.NewPCG(1, 2))
+		}()
+	}
+}
+
+const benchSkipListSize = 1 << 12
+
+// BenchmarkSkipList inserts, looks up, ranges over and deletes keys in a
+// list of benchSkipListSize keys. Running it with a larger size should
+// only grow the time per operation logarithmically.
+func BenchmarkSkipList(b *testing.B) {
+	s := NewSkipList[int, int](16, 0.5, rand.NewPCG(1, 2))
+	r := rand.New(rand.NewPCG(5, 6))
+	for range benchSkipListSize {
+		s.Set(r.IntN(benchSkipListSize*4), 0)
+	}
+	b.ResetTimer()
+	for i := range b.N {
+		k := r.IntN(benchSkipListSize * 4)
+		s.Set(k, i)
+		s.Get(k ^ 1)
+		s.Range(k, k+8, func(int, int) bool { return true })
+		s.Delete(k)
+	}
+}
+
+// BenchmarkMapSortedKeys does the same with a map and a sorted slice of
+// its keys. Inserting and deleting is O(n) there, which only overtakes the
+// cheap copy of small slices once benchSkipListSize is raised.
+func BenchmarkMapSortedKeys(b *testing.B) {
+	m := map[int]int{}
+	var keys []int
+	set := func(k, v int) {
+		if _, ok := m[k]; !ok {
+			i := sort.SearchInts(keys, k)
+			keys = slices.Insert(keys, i, k)
+		}
+		m[k] = v
+	}
+	r := rand.New(rand.NewPCG(5, 6))
+	for range benchSkipListSize {
+		set(r.IntN(benchSkipListSize*4), 0)
+	}
+	b.ResetTimer()
+	for i := range b.N {
+		k := r.IntN(benchSkipListSize * 4)
+		set(k, i)
+		_ = m[k^1]
+		for j := sort.SearchInts(keys, k); j < len(keys) && keys[j] < k+8; j++ {
+			_ = m[keys[j]]
+		}
+		if _, ok := m[k]; ok {
+			delete(m, k)
+			keys = slices.Delete(keys, sort.SearchInts(keys, k), sort.SearchInts(keys, k)+1)
+		}
+	}
+}
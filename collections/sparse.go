@@ -0,0 +1,71 @@
+package collections
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// SparseArray is an array of unbounded length whose elements default to the
+// zero value of T. Only explicitly set elements take up memory. The zero
+// value is an empty array ready to use.
+type SparseArray[T any] struct {
+	entries map[int]T
+}
+
+// Get returns the element at index i, or the zero value if it was never
+// set.
+func (a *SparseArray[T]) Get(i int) T {
+	return a.entries[i]
+}
+
+// Set stores v at index i. Setting the zero value removes the element like
+// SetDefault, so only non-zero elements are stored. Set panics if i is
+// negative.
+func (a *SparseArray[T]) Set(i int, v T) {
+	if i < 0 {
+		panic(fmt.Sprintf("collections: negative SparseArray index %d", i))
+	}
+	if reflect.ValueOf(&v).Elem().IsZero() {
+		delete(a.entries, i)
+		return
+	}
+	if a.entries == nil {
+		a.entries = make(map[int]T)
+	}
+	a.entries[i] = v
+}
+
+// SetDefault resets the element at index i to the zero value and releases
+// its memory.
+func (a *SparseArray[T]) SetDefault(i int) {
+	delete(a.entries, i)
+}
+
+// Len returns the number of non-zero elements.
+func (a *SparseArray[T]) Len() int {
+	return len(a.entries)
+}
+
+// NonZeroIndices returns the indices of the non-zero elements in ascending
+// order.
+func (a *SparseArray[T]) NonZeroIndices() []int {
+	indices := make([]int, 0, len(a.entries))
+	for i := range a.entries {
+		indices = append(indices, i)
+	}
+	slices.Sort(indices)
+	return indices
+}
+
+// ToDense returns the first size elements as a slice. Stored elements at
+// indices beyond size are left out.
+func (a *SparseArray[T]) ToDense(size int) []T {
+	dense := make([]T, size)
+	for i, v := range a.entries {
+		if i < size {
+			dense[i] = v
+		}
+	}
+	return dense
+}
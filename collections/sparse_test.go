@@ -0,0 +1,69 @@
+package collections
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSparseArray(t *testing.T) {
+	var a SparseArray[customInt[int]]
+	if got := a.Get(5); got != (customInt[int]{}) {
+		t.Errorf("Get(5) of the zero SparseArray = %v, want the zero value", got)
+	}
+	a.Set(1000, customInt[int]{7})
+	a.Set(3, customInt[int]{1})
+	a.Set(42, customInt[int]{2})
+	if got := a.Get(1000); got != (customInt[int]{7}) {
+		t.Errorf("Get(1000) = %v, want {7}", got)
+	}
+	if a.Len() != 3 {
+		t.Errorf("Len() = %d, want 3", a.Len())
+	}
+	if got, want := a.NonZeroIndices(), []int{3, 42, 1000}; !reflect.DeepEqual(got, want) {
+		t.Errorf("NonZeroIndices() = %v, want %v", got, want)
+	}
+
+	a.SetDefault(42)
+	a.SetDefault(7) // never set
+	a.Set(3, customInt[int]{})
+	if a.Len() != 1 {
+		t.Errorf("Len() after resetting = %d, want 1", a.Len())
+	}
+	if got := a.Get(3); got != (customInt[int]{}) {
+		t.Errorf("Get(3) after setting the zero value = %v", got)
+	}
+}
+
+func TestSparseArrayToDense(t *testing.T) {
+	var a SparseArray[customInt[int]]
+	a.Set(1, customInt[int]{5})
+	a.Set(10, customInt[int]{6})
+	want := []customInt[int]{{}, {5}, {}, {}}
+	if got := a.ToDense(4); !reflect.DeepEqual(got, want) {
+		t.Errorf("ToDense(4) = %v, want %v", got, want)
+	}
+	if got := a.ToDense(0); len(got) != 0 {
+		t.Errorf("ToDense(0) = %v, want empty", got)
+	}
+}
+
+// TestSparseArrayZeroValues checks which values count as zero for types
+// that are not comparable with ==.
+func TestSparseArrayZeroValues(t *testing.T) {
+	var a SparseArray[[]int]
+	a.Set(0, nil)
+	a.Set(1, []int{})
+	if got, want := a.NonZeroIndices(), []int{1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("NonZeroIndices() = %v, want %v", got, want)
+	}
+}
+
+func TestSparseArrayNegativeIndex(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Set(-1) did not panic")
+		}
+	}()
+	var a SparseArray[int]
+	a.Set(-1, 1)
+}
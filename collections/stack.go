@@ -0,0 +1,101 @@
+package collections
+
+import "sync"
+
+// Stack is a last-in-first-out collection backed by a slice. The zero value
+// is an empty stack ready to use. A Stack is not safe for concurrent use;
+// see SafeStack.
+type Stack[T any] struct {
+	elems []T
+}
+
+// NewStack returns an empty stack with room for initialCap elements.
+func NewStack[T any](initialCap int) *Stack[T] {
+	return &Stack[T]{elems: make([]T, 0, initialCap)}
+}
+
+// Push adds v to the top of the stack.
+func (s *Stack[T]) Push(v T) {
+	s.elems = append(s.elems, v)
+}
+
+// Pop removes and returns the top element. It returns the zero value and
+// false if the stack is empty.
+func (s *Stack[T]) Pop() (T, bool) {
+	var zero T
+	if len(s.elems) == 0 {
+		return zero, false
+	}
+	last := len(s.elems) - 1
+	v := s.elems[last]
+	s.elems[last] = zero
+	s.elems = s.elems[:last]
+	return v, true
+}
+
+// Peek returns the top element without removing it. It returns the zero
+// value and false if the stack is empty.
+func (s *Stack[T]) Peek() (T, bool) {
+	if len(s.elems) == 0 {
+		var zero T
+		return zero, false
+	}
+	return s.elems[len(s.elems)-1], true
+}
+
+// Len returns the number of elements on the stack.
+func (s *Stack[T]) Len() int {
+	return len(s.elems)
+}
+
+// Cap returns the number of elements the stack can hold without reallocating.
+func (s *Stack[T]) Cap() int {
+	return cap(s.elems)
+}
+
+// IsEmpty reports whether the stack has no elements.
+func (s *Stack[T]) IsEmpty() bool {
+	return len(s.elems) == 0
+}
+
+// SafeStack is a Stack that may be used from multiple goroutines. The zero
+// value is an empty stack ready to use.
+type SafeStack[T any] struct {
+	mu    sync.Mutex
+	stack Stack[T]
+}
+
+// Push adds v to the top of the stack.
+func (s *SafeStack[T]) Push(v T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stack.Push(v)
+}
+
+// Pop removes and returns the top element. It returns the zero value and
+// false if the stack is empty.
+func (s *SafeStack[T]) Pop() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.Pop()
+}
+
+// Peek returns the top element without removing it. It returns the zero
+// value and false if the stack is empty.
+func (s *SafeStack[T]) Peek() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.Peek()
+}
+
+// Len returns the number of elements on the stack.
+func (s *SafeStack[T]) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.Len()
+}
+
+// IsEmpty reports whether the stack has no elements.
+func (s *SafeStack[T]) IsEmpty() bool {
+	return s.Len() == 0
+}
@@ -0,0 +1,113 @@
+package collections
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestStack(t *testing.T) {
+	var s Stack[int]
+	if _, ok := s.Pop(); ok {
+		t.Error("Pop on empty stack succeeded")
+	}
+	if _, ok := s.Peek(); ok {
+		t.Error("Peek on empty stack succeeded")
+	}
+	if !s.IsEmpty() {
+		t.Error("zero Stack is not empty")
+	}
+	for i := range 3 {
+		s.Push(i)
+	}
+	if v, ok := s.Peek(); v != 2 || !ok {
+		t.Errorf("Peek() = %v, %v, want 2, true", v, ok)
+	}
+	for want := 2; want >= 0; want-- {
+		if v, ok := s.Pop(); v != want || !ok {
+			t.Errorf("Pop() = %v, %v, want %v, true", v, ok, want)
+		}
+	}
+	if s.Len() != 0 {
+		t.Errorf("Len() = %d after popping everything", s.Len())
+	}
+}
+
+func TestNewStackCap(t *testing.T) {
+	s := NewStack[string](8)
+	if s.Cap() != 8 || s.Len() != 0 {
+		t.Errorf("Cap() = %d, Len() = %d, want 8, 0", s.Cap(), s.Len())
+	}
+}
+
+func TestSafeStackConcurrent(t *testing.T) {
+	var s SafeStack[int]
+	const workers, perWorker = 8, 1000
+	var wg sync.WaitGroup
+	for w := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range perWorker {
+				s.Push(w*perWorker + i)
+			}
+		}()
+	}
+	wg.Wait()
+	if s.Len() != workers*perWorker {
+		t.Fatalf("Len() = %d, want %d", s.Len(), workers*perWorker)
+	}
+
+	seen := make([]bool, workers*perWorker)
+	var mu sync.Mutex
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				v, ok := s.Pop()
+				if !ok {
+					return
+				}
+				mu.Lock()
+				if seen[v] {
+					t.Errorf("popped %d twice", v)
+				}
+				seen[v] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	for v, ok := range seen {
+		if !ok {
+			t.Fatalf("%d was never popped", v)
+		}
+	}
+	if !s.IsEmpty() {
+		t.Error("stack not empty after popping everything")
+	}
+}
+
+func BenchmarkStack(b *testing.B) {
+	s := NewStack[int](1024)
+	for range b.N {
+		for i := range 1024 {
+			s.Push(i)
+		}
+		for range 1024 {
+			s.Pop()
+		}
+	}
+}
+
+func BenchmarkSlice(b *testing.B) {
+	s := make([]int, 0, 1024)
+	for range b.N {
+		for i := range 1024 {
+			s = append(s, i)
+		}
+		for range 1024 {
+			s = s[:len(s)-1]
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package collections
+
+import (
+	"slices"
+	"unicode/utf8"
+
+	"projektarbeit-go-generics/tuple"
+)
+
+type trieNode[V any] struct {
+	children map[rune]*trieNode[V]
+	value    V
+	hasValue bool
+}
+
+// Trie maps string keys to values and supports prefix lookups. Keys are
+// split into runes, so multi-byte characters are handled correctly and a
+// prefix never ends inside a character. Bytes that are not valid UTF-8 are
+// kept apart from each other and from U+FFFD, so every string is a distinct
+// key. The zero value is an empty trie ready to use.
+type Trie[V any] struct {
+	root trieNode[V]
+	size int
+}
+
+// invalidByteBase maps a byte b that is not valid UTF-8 to the rune
+// invalidByteBase+b. The results are low surrogates, which decoding a
+// string never yields.
+const invalidByteBase = 0xDC00
+
+// trieRune decodes the rune of s starting at byte offset i and returns it
+// with its length in bytes.
+func trieRune(s string, i int) (rune, int) {
+	r, size := utf8.DecodeRuneInString(s[i:])
+	if r == utf8.RuneError && size == 1 {
+		r = invalidByteBase + rune(s[i])
+	}
+	return r, size
+}
+
+// appendTrieRune is the inverse of trieRune.
+func appendTrieRune(key []byte, r rune) []byte {
+	if r >= invalidByteBase+0x80 && r <= invalidByteBase+0xFF {
+		return append(key, byte(r-invalidByteBase))
+	}
+	return utf8.AppendRune(key, r)
+}
+
+// Set stores val for key.
+func (t *Trie[V]) Set(key string, val V) {
+	n := &t.root
+	for i := 0; i < len(key); {
+		r, size := trieRune(key, i)
+		i += size
+		if n.children == nil {
+			n.children = make(map[rune]*trieNode[V])
+		}
+		child, ok := n.children[r]
+		if !ok {
+			child = &trieNode[V]{}
+			n.children[r] = child
+		}
+		n = child
+	}
+	if !n.hasValue {
+		t.size++
+	}
+	n.value, n.hasValue = val, true
+}
+
+func (t *Trie[V]) find(prefix string) *trieNode[V] {
+	n := &t.root
+	for i := 0; i < len(prefix); {
+		r, size := trieRune(prefix, i)
+		i += size
+		if n = n.children[r]; n == nil {
+			return nil
+		}
+	}
+	return n
+}
+
+// Get returns the value stored for key.
+func (t *Trie[V]) Get(key string) (V, bool) {
+	if n := t.find(key); n != nil && n.hasValue {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Delete removes key and reports whether it was present. Nodes that no
+// longer lead to any value are pruned.
+func (t *Trie[V]) Delete(key string) bool {
+	var path []*trieNode[V]
+	var runes []rune
+	n := &t.root
+	for i := 0; i < len(key); {
+		r, size := trieRune(key, i)
+		i += size
+		path = append(path, n)
+		runes = append(runes, r)
+		if n = n.children[r]; n == nil {
+			return false
+		}
+	}
+	if !n.hasValue {
+		return false
+	}
+	var zero V
+	n.value, n.hasValue = zero, false
+	t.size--
+
+	for i := len(path) - 1; i >= 0 && !n.hasValue && len(n.children) == 0; i-- {
+		delete(path[i].children, runes[i])
+		n = path[i]
+	}
+	return true
+}
+
+// HasPrefix reports whether any key starts with prefix.
+func (t *Trie[V]) HasPrefix(prefix string) bool {
+	n := t.find(prefix)
+	return n != nil && (n.hasValue || len(n.children) > 0)
+}
+
+// AllWithPrefix returns all entries whose key starts with prefix, ordered
+// lexicographically by rune. For valid UTF-8 keys this is the order of
+// strings.Compare.
+func (t *Trie[V]) AllWithPrefix(prefix string) []tuple.Pair[string, V] {
+	var result []tuple.Pair[string, V]
+	if n := t.find(prefix); n != nil {
+		collectTrie(n, []byte(prefix), &result)
+	}
+	return result
+}
+
+func collectTrie[V any](n *trieNode[V], key []byte, result *[]tuple.Pair[string, V]) {
+	if n.hasValue {
+		*result = append(*result, tuple.NewPair(string(key), n.value))
+	}
+	next := make([]rune, 0, len(n.children))
+	for r := range n.children {
+		next = append(next, r)
+	}
+	slices.Sort(next)
+	for _, r := range next {
+		collectTrie(n.children[r], appendTrieRune(key, r), result)
+	}
+}
+
+// LongestPrefixMatch returns the longest key that is a prefix of s.
+func (t *Trie[V]) LongestPrefixMatch(s string) (key string, val V, ok bool) {
+	n := &t.root
+	if n.hasValue {
+		val, ok = n.value, true
+	}
+	for i := 0; i < len(s); {
+		r, size := trieRune(s, i)
+		i += size
+		if n = n.children[r]; n == nil {
+			break
+		}
+		if n.hasValue {
+			key, val, ok = s[:i], n.value, true
+		}
+	}
+	return key, val, ok
+}
+
+// Len returns the number of keys.
+func (t *Trie[V]) Len() int {
+	return t.size
+}
@@ -0,0 +1,140 @@
+package collections
+
+import (
+	"reflect"
+	"testing"
+
+	"projektarbeit-go-generics/tuple"
+)
+
+func trieKeys[V any](pairs []tuple.Pair[string, V]) []string {
+	var keys []string
+	for _, p := range pairs {
+		keys = append(keys, p.First)
+	}
+	return keys
+}
+
+func TestTrieSetGet(t *testing.T) {
+	var tr Trie[int]
+	tr.Set("go", 1)
+	tr.Set("gopher", 2)
+	tr.Set("go", 3)
+	if tr.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", tr.Len())
+	}
+	if v, ok := tr.Get("go"); !ok || v != 3 {
+		t.Errorf("Get(go) = %d, %v, want 3, true", v, ok)
+	}
+	if _, ok := tr.Get("goph"); ok {
+		t.Error("Get(goph) found an inner node")
+	}
+	if !tr.HasPrefix("goph") || tr.HasPrefix("gox") {
+		t.Errorf("HasPrefix(goph), HasPrefix(gox) = %v, %v, want true, false", tr.HasPrefix("goph"), tr.HasPrefix("gox"))
+	}
+}
+
+func TestTrieAllWithPrefixOrder(t *testing.T) {
+	var tr Trie[int]
+	for i, k := range []string{"tea", "ten", "to", "t", "inn", "te", "täg", "tz"} {
+		tr.Set(k, i)
+	}
+	want := []string{"t", "te", "tea", "ten", "to", "tz", "täg"}
+	if got := trieKeys(tr.AllWithPrefix("t")); !reflect.DeepEqual(got, want) {
+		t.Errorf("AllWithPrefix(t) = %v, want %v", got, want)
+	}
+	if got := trieKeys(tr.AllWithPrefix("te")); !reflect.DeepEqual(got, []string{"te", "tea", "ten"}) {
+		t.Errorf("AllWithPrefix(te) = %v, want [te tea ten]", got)
+	}
+	if got := tr.AllWithPrefix("x"); got != nil {
+		t.Errorf("AllWithPrefix(x) = %v, want nil", got)
+	}
+	if got := len(tr.AllWithPrefix("")); got != 8 {
+		t.Errorf("AllWithPrefix(\"\") returned %d entries, want 8", got)
+	}
+}
+
+func TestTrieDeletePrunes(t *testing.T) {
+	var tr Trie[int]
+	tr.Set("car", 1)
+	tr.Set("cart", 2)
+	tr.Set("cat", 3)
+	if tr.Delete("ca") {
+		t.Error("Delete(ca) removed an inner node")
+	}
+	if !tr.Delete("cart") || tr.Delete("cart") {
+		t.Error("Delete(cart) twice did not return true, false")
+	}
+	car := tr.root.children['c'].children['a'].children['r']
+	if len(car.children) != 0 {
+		t.Errorf("node of car still has children %v after deleting cart", car.children)
+	}
+	tr.Delete("car")
+	tr.Delete("cat")
+	if tr.Len() != 0 || len(tr.root.children) != 0 {
+		t.Errorf("Len() = %d and root has %d children after deleting everything, want 0 and 0", tr.Len(), len(tr.root.children))
+	}
+}
+
+func TestTrieLongestPrefixMatch(t *testing.T) {
+	var tr Trie[string]
+	tr.Set("/", "root")
+	tr.Set("/api", "api")
+	tr.Set("/api/users", "users")
+	for _, tc := range []struct{ s, key, val string }{
+		{"/api/users/42", "/api/users", "users"},
+		{"/api/user", "/api", "api"},
+		{"/static", "/", "root"},
+	} {
+		if key, val, ok := tr.LongestPrefixMatch(tc.s); !ok || key != tc.key || val != tc.val {
+			t.Errorf("LongestPrefixMatch(%q) = %q, %q, %v, want %q, %q, true", tc.s, key, val, ok, tc.key, tc.val)
+		}
+	}
+	if _, _, ok := tr.LongestPrefixMatch("api"); ok {
+		t.Error("LongestPrefixMatch(api) matched without a leading slash")
+	}
+	var empty Trie[int]
+	empty.Set("", 7)
+	if key, val, ok := empty.LongestPrefixMatch("abc"); !ok || key != "" || val != 7 {
+		t.Errorf("LongestPrefixMatch with an empty key = %q, %d, %v, want \"\", 7, true", key, val, ok)
+	}
+}
+
+func TestTrieMultiByteKeys(t *testing.T) {
+	var tr Trie[int]
+	tr.Set("日本", 1)
+	tr.Set("日本語", 2)
+	tr.Set("über", 3)
+	if len(tr.root.children['日'].children) != 1 {
+		t.Errorf("日 has %d children, want one per rune", len(tr.root.children['日'].children))
+	}
+	// "\xe6\x97" is the first two bytes of 日, which is no prefix of any key
+	// when comparing by rune.
+	if tr.HasPrefix("\xe6\x97") {
+		t.Error("HasPrefix matched a split character")
+	}
+	if key, val, ok := tr.LongestPrefixMatch("日本語です"); !ok || key != "日本語" || val != 2 {
+		t.Errorf("LongestPrefixMatch(日本語です) = %q, %d, %v, want 日本語, 2, true", key, val, ok)
+	}
+	if got := trieKeys(tr.AllWithPrefix("日")); !reflect.DeepEqual(got, []string{"日本", "日本語"}) {
+		t.Errorf("AllWithPrefix(日) = %v", got)
+	}
+}
+
+func TestTrieInvalidUTF8(t *testing.T) {
+	var tr Trie[int]
+	tr.Set("a\xff", 1)
+	tr.Set("a\xfe", 2)
+	tr.Set("a�", 3)
+	if tr.Len() != 3 {
+		t.Errorf("Len() = %d, want 3 distinct keys", tr.Len())
+	}
+	for k, want := range map[string]int{"a\xff": 1, "a\xfe": 2, "a�": 3} {
+		if v, ok := tr.Get(k); !ok || v != want {
+			t.Errorf("Get(%q) = %d, %v, want %d, true", k, v, ok, want)
+		}
+	}
+	if got, want := trieKeys(tr.AllWithPrefix("a")), []string{"a\xfe", "a\xff", "a�"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("AllWithPrefix(a) = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"fmt"
+	"go/token"
+	"go/types"
+	"strings"
+)
+
+// constCheck evaluates "T satisfies Constraint" and reports whether the type
+// T is in the type set of the interface Constraint.
+func constCheck(c checkedPackage, pos token.Pos, scope *types.Scope, args string) string {
+	typeName, constraintName, ok := strings.Cut(args, " satisfies ")
+	if !ok {
+		return "\t<invalid directive, expected: T satisfies Constraint>\n"
+	}
+	typeName, constraintName = strings.TrimSpace(typeName), strings.TrimSpace(constraintName)
+
+	t, ok := lookupType(c.pkg, scope, pos, typeName)
+	if !ok {
+		return fmt.Sprintf("\t<type %s not found>\n", typeName)
+	}
+	constraint, ok := lookupType(c.pkg, scope, pos, constraintName)
+	if !ok {
+		return fmt.Sprintf("\t<constraint %s not found>\n", constraintName)
+	}
+	iface, ok := constraint.Underlying().(*types.Interface)
+	if !ok {
+		return fmt.Sprintf("\t<%s is not an interface>\n", constraintName)
+	}
+
+	if types.Satisfies(t, iface) {
+		return fmt.Sprintf("\t✓ %s satisfies %s\n", typeName, constraintName)
+	}
+	return fmt.Sprintf("\t✗ %s does not satisfy %s: %s\n", typeName, constraintName, unsatisfiedReason(t, iface))
+}
+
+// lookupType resolves the type named name, which may be qualified like
+// fmt.Stringer and start with * to denote the pointer type.
+func lookupType(pkg *types.Package, scope *types.Scope, pos token.Pos, name string) (types.Type, bool) {
+	if elem, ok := strings.CutPrefix(name, "*"); ok {
+		t, ok := lookupType(pkg, scope, pos, elem)
+		if !ok {
+			return nil, false
+		}
+		return types.NewPointer(t), true
+	}
+	obj, _ := lookupSelector(pkg, scope, pos, name)
+	tn, ok := obj.(*types.TypeName)
+	if !ok {
+		return nil, false
+	}
+	return tn.Type(), true
+}
+
+func unsatisfiedReason(t types.Type, iface *types.Interface) string {
+	if method, wrongType := types.MissingMethod(t, iface, true); method != nil {
+		sig := strings.TrimPrefix(method.Type().String(), "func")
+		if wrongType {
+			return fmt.Sprintf("method %s has the wrong signature, want %s%s", method.Name(), method.Name(), sig)
+		}
+		return fmt.Sprintf("missing method %s%s", method.Name(), sig)
+	}
+	if iface.IsComparable() && !types.Comparable(t) {
+		return fmt.Sprintf("%s is not comparable", t.String())
+	}
+	return fmt.Sprintf("%s is not in the type set of %s", t.String(), iface.String())
+}
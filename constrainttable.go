@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+	"go/token"
+	"go/types"
+	"io"
+	"strings"
+)
+
+type tableConstraint struct {
+	header     string
+	constraint *types.Interface
+	usedBy     []string
+}
+
+// PrintConstraintTable writes a markdown table to w that tests every named
+// type of pkg against the constraints of every type parameter declared by
+// a generic function or type in pkg. Rows are types, columns are
+// constraints, and identical constraints share a column. A list below the
+// table names the declarations using each constraint. Constraints that refer
+// to other type parameters are never satisfied by a plain type.
+func PrintConstraintTable(w io.Writer, fset *token.FileSet, pkg *types.Package) error {
+	var constraints []*tableConstraint
+	seen := make(map[string]*tableConstraint)
+	var rows []*types.TypeName
+
+	nameOpts := DefaultTypeNameOptions
+	nameOpts.ExpandAliases = false // Keep any instead of interface{}
+	scope := pkg.Scope()
+	for _, name := range scope.Names() {
+		var tparams *types.TypeParamList
+		obj := scope.Lookup(name)
+		switch obj := obj.(type) {
+		case *types.Func:
+			tparams = obj.Type().(*types.Signature).TypeParams()
+		case *types.TypeName:
+			if named, ok := obj.Type().(*types.Named); ok && named.TypeParams() != nil {
+				tparams = named.TypeParams()
+			} else if iface, ok := obj.Type().Underlying().(*types.Interface); !ok || iface.IsMethodSet() {
+				rows = append(rows, obj)
+			}
+		}
+		for i := range tparams.Len() {
+			constraint := tparams.At(i).Constraint()
+			header := nameOpts.Normalize(constraint, pkg)
+			tc, ok := seen[header]
+			if !ok {
+				tc = &tableConstraint{header: header, constraint: constraint.Underlying().(*types.Interface)}
+				seen[header] = tc
+				constraints = append(constraints, tc)
+			}
+			tc.usedBy = append(tc.usedBy, fmt.Sprintf("%s[%s] (%s)", obj.Name(), tparams.At(i).Obj().Name(), fset.Position(obj.Pos())))
+		}
+	}
+	if len(constraints) == 0 || len(rows) == 0 {
+		_, err := fmt.Fprintf(w, "No generic declarations or named types in package %s.\n", pkg.Name())
+		return err
+	}
+
+	buff := &strings.Builder{}
+	buff.WriteString("| Type |")
+	for _, c := range constraints {
+		fmt.Fprintf(buff, " `%s` |", strings.ReplaceAll(c.header, "|", `\|`))
+	}
+	buff.WriteString("\n|---|" + strings.Repeat("---|", len(constraints)) + "\n")
+	for _, tn := range rows {
+		fmt.Fprintf(buff, "| `%s` |", tn.Name())
+		for _, c := range constraints {
+			mark := "✗"
+			if types.Satisfies(tn.Type(), c.constraint) {
+				mark = "✓"
+			}
+			fmt.Fprintf(buff, " %s |", mark)
+		}
+		buff.WriteString("\n")
+	}
+	buff.WriteString("\n")
+	for _, c := range constraints {
+		fmt.Fprintf(buff, "- `%s`: %s\n", strings.ReplaceAll(c.header, "|", `\|`), strings.Join(c.usedBy, ", "))
+	}
+	_, err := io.WriteString(w, buff.String())
+	return err
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func constraintTable(t *testing.T, code string) string {
+	t.Helper()
+	c := checkSource(t, code)
+	buff := &strings.Builder{}
+	if err := PrintConstraintTable(buff, c.fset, c.pkg); err != nil {
+		t.Fatal(err)
+	}
+	return buff.String()
+}
+
+func TestConstraintTableGolden(t *testing.T) {
+	got := constraintTable(t, readExample(t, "testdata/constraints.go"))
+	checkGolden(t, "testdata/constraints.md.golden", got)
+}
+
+func TestConstraintTableMyInt(t *testing.T) {
+	got := constraintTable(t, readExample(t, "testdata/constraints.go"))
+	// The columns are the constraints of customInt, first, show, sum and
+	// tildeInt in scope order. MyInt lacks the tilde for customInt.
+	for _, want := range []string{
+		"| `MyInt` | ✗ | ✓ | ✓ | ✓ | ✓ |\n",
+		"| `MyStruct` | ✗ | ✓ | ✗ | ✗ | ✗ |\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("table does not contain %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestConstraintTableEmpty(t *testing.T) {
+	got := constraintTable(t, "package main\n\ntype MyInt int\n")
+	if want := "No generic declarations or named types in package main.\n"; got != want {
+		t.Errorf("table of a package without generics = %q, want %q", got, want)
+	}
+}
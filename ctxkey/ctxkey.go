@@ -0,0 +1,56 @@
+// Package ctxkey provides type-safe keys for context values.
+package ctxkey
+
+import (
+	"context"
+	"reflect"
+)
+
+// CtxKey is a context key for values of type T. Keys for different value
+// types never collide, even if they share a name. Keys with the same value
+// type and name are equal, so pick names that are unique within T.
+type CtxKey[T any] struct {
+	name string
+}
+
+// NewCtxKey returns a key named name for values of type T.
+func NewCtxKey[T any](name string) CtxKey[T] {
+	return CtxKey[T]{name: name}
+}
+
+// Name returns the name of the key.
+func (k CtxKey[T]) Name() string {
+	return k.name
+}
+
+// String formats the key together with its value type, e.g. "user (string)".
+func (k CtxKey[T]) String() string {
+	return k.name + " (" + reflect.TypeFor[T]().String() + ")"
+}
+
+// entry wraps stored values so that a nil interface value can be told
+// apart from a missing one.
+type entry[T any] struct {
+	val T
+}
+
+// SetCtx returns a copy of ctx in which key is associated with val.
+func SetCtx[T any](ctx context.Context, key CtxKey[T], val T) context.Context {
+	return context.WithValue(ctx, key, entry[T]{val: val})
+}
+
+// GetCtx returns the value associated with key in ctx. It reports false if
+// ctx holds no value for key.
+func GetCtx[T any](ctx context.Context, key CtxKey[T]) (T, bool) {
+	e, ok := ctx.Value(key).(entry[T])
+	return e.val, ok
+}
+
+// MustGetCtx is like GetCtx but panics if ctx holds no value for key.
+func MustGetCtx[T any](ctx context.Context, key CtxKey[T]) T {
+	v, ok := GetCtx(ctx, key)
+	if !ok {
+		panic("ctxkey: no value for key " + key.String())
+	}
+	return v
+}
@@ -0,0 +1,61 @@
+package ctxkey
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSetGet(t *testing.T) {
+	user := NewCtxKey[string]("user")
+	ctx := SetCtx(context.Background(), user, "gopher")
+	if v, ok := GetCtx(ctx, user); v != "gopher" || !ok {
+		t.Errorf("GetCtx = %q, %v, want \"gopher\", true", v, ok)
+	}
+	if v := MustGetCtx(ctx, user); v != "gopher" {
+		t.Errorf("MustGetCtx = %q, want \"gopher\"", v)
+	}
+}
+
+func TestSameNameDifferentTypes(t *testing.T) {
+	asString := NewCtxKey[string]("id")
+	asInt := NewCtxKey[int]("id")
+	ctx := SetCtx(context.Background(), asString, "abc")
+	if v, ok := GetCtx(ctx, asInt); v != 0 || ok {
+		t.Errorf("GetCtx with the int key = %d, %v, want 0, false", v, ok)
+	}
+	ctx = SetCtx(ctx, asInt, 42)
+	if v, _ := GetCtx(ctx, asString); v != "abc" {
+		t.Errorf("GetCtx with the string key = %q after setting the int key, want \"abc\"", v)
+	}
+}
+
+func TestMissing(t *testing.T) {
+	key := NewCtxKey[[]int]("numbers")
+	if v, ok := GetCtx(context.Background(), key); v != nil || ok {
+		t.Errorf("GetCtx on an empty context = %v, %v, want nil, false", v, ok)
+	}
+	defer func() {
+		if got := recover(); got != "ctxkey: no value for key numbers ([]int)" {
+			t.Errorf("MustGetCtx panicked with %v", got)
+		}
+	}()
+	MustGetCtx(context.Background(), key)
+}
+
+func TestNilInterfaceValue(t *testing.T) {
+	key := NewCtxKey[error]("err")
+	ctx := SetCtx(context.Background(), key, nil)
+	if v, ok := GetCtx(ctx, key); v != nil || !ok {
+		t.Errorf("GetCtx of a stored nil = %v, %v, want nil, true", v, ok)
+	}
+}
+
+func TestKeyString(t *testing.T) {
+	key := NewCtxKey[map[string]int]("counts")
+	if key.Name() != "counts" {
+		t.Errorf("Name() = %q, want \"counts\"", key.Name())
+	}
+	if got, want := key.String(), "counts (map[string]int)"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
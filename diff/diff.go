@@ -0,0 +1,207 @@
+// Package diff computes shortest edit scripts between slices.
+package diff
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// OpKind tells what a DiffOp does.
+type OpKind int
+
+const (
+	// Keep copies an element of a to the output.
+	Keep OpKind = iota
+	// Insert adds an element of b.
+	Insert
+	// Delete drops an element of a.
+	Delete
+)
+
+func (k OpKind) String() string {
+	switch k {
+	case Keep:
+		return "keep"
+	case Insert:
+		return "insert"
+	case Delete:
+		return "delete"
+	}
+	return "unknown"
+}
+
+// DiffOp is a single step of an edit script. Value is the element kept,
+// inserted or deleted.
+type DiffOp[T any] struct {
+	Kind  OpKind
+	Value T
+}
+
+// Diff returns a shortest edit script that turns a into b, computed with
+// Myers' algorithm. It runs in O((N+M)·D) time and keeps O((N+M)·D) memory
+// for backtracking, where D is the number of inserted and deleted elements.
+func Diff[T comparable](a, b []T) []DiffOp[T] {
+	n, m := len(a), len(b)
+	offset := n + m + 1
+	// v[offset+k] is the furthest x reached on diagonal k = x - y.
+	v := make([]int, 2*offset+1)
+	var trace [][]int
+	for d := 0; d <= n+m; d++ {
+		trace = append(trace, slices.Clone(v))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				return backtrack(trace, offset, a, b)
+			}
+		}
+	}
+	panic("diff: unreachable")
+}
+
+// backtrack walks the recorded furthest-reaching paths from the end of both
+// slices back to the start and collects the edit script.
+func backtrack[T any](trace [][]int, offset int, a, b []T) []DiffOp[T] {
+	var ops []DiffOp[T]
+	x, y := len(a), len(b)
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := v[offset+prevK]
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			ops = append(ops, DiffOp[T]{Kind: Keep, Value: a[x-1]})
+			x--
+			y--
+		}
+		if d > 0 {
+			if x == prevX {
+				ops = append(ops, DiffOp[T]{Kind: Insert, Value: b[y-1]})
+			} else {
+				ops = append(ops, DiffOp[T]{Kind: Delete, Value: a[x-1]})
+			}
+		}
+		x, y = prevX, prevY
+	}
+	slices.Reverse(ops)
+	return ops
+}
+
+// Apply runs the edit script ops on a and returns the result, so that
+// Apply(a, Diff(a, b)) equals b. It panics if ops does not fit a.
+func Apply[T comparable](a []T, ops []DiffOp[T]) []T {
+	var out []T
+	i := 0
+	for _, op := range ops {
+		switch op.Kind {
+		case Keep, Delete:
+			if i >= len(a) || a[i] != op.Value {
+				panic(fmt.Sprintf("diff: %s of %v does not match element %d", op.Kind, op.Value, i))
+			}
+			if op.Kind == Keep {
+				out = append(out, a[i])
+			}
+			i++
+		case Insert:
+			out = append(out, op.Value)
+		}
+	}
+	if i != len(a) {
+		panic(fmt.Sprintf("diff: edit script leaves %d elements of a unconsumed", len(a)-i))
+	}
+	return out
+}
+
+// UnifiedDiff formats the differences between a and b in unified diff
+// format, one element per line, with up to context unchanged elements
+// around every change. It returns the empty string if a and b are equal.
+func UnifiedDiff[T comparable](a, b []T, context int) string {
+	ops := Diff(a, b)
+	context = max(context, 0)
+
+	buff := &strings.Builder{}
+	oldLine, newLine := 0, 0 // elements of a and b before ops[i]
+	for i := 0; i < len(ops); {
+		if ops[i].Kind == Keep {
+			oldLine++
+			newLine++
+			i++
+			continue
+		}
+
+		// Extend the hunk until more than 2*context elements are kept in
+		// a row, or the script ends.
+		start := max(i-context, 0)
+		for start < i && ops[start].Kind != Keep {
+			start++
+		}
+		end := i
+		for j := i; j < len(ops); j++ {
+			if ops[j].Kind != Keep {
+				end = j + 1
+			} else if j-end >= 2*context {
+				break
+			}
+		}
+		end = min(end+context, len(ops))
+
+		oldStart, newStart := oldLine-(i-start), newLine-(i-start)
+		var oldCount, newCount int
+		for _, op := range ops[start:end] {
+			if op.Kind != Insert {
+				oldCount++
+			}
+			if op.Kind != Delete {
+				newCount++
+			}
+		}
+		fmt.Fprintf(buff, "@@ -%s +%s @@\n", hunkRange(oldStart, oldCount), hunkRange(newStart, newCount))
+		for _, op := range ops[start:end] {
+			prefix := " "
+			switch op.Kind {
+			case Insert:
+				prefix = "+"
+			case Delete:
+				prefix = "-"
+			}
+			fmt.Fprintf(buff, "%s%v\n", prefix, op.Value)
+		}
+
+		for _, op := range ops[i:end] {
+			if op.Kind != Insert {
+				oldLine++
+			}
+			if op.Kind != Delete {
+				newLine++
+			}
+		}
+		i = end
+	}
+	return buff.String()
+}
+
+// hunkRange formats the range of a hunk header. Lines are numbered from 1;
+// an empty range names the line before it.
+func hunkRange(start, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
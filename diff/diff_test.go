@@ -0,0 +1,136 @@
+package diff
+
+import (
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// editDistance returns the number of insertions and deletions of a
+// shortest edit script, computed with the textbook LCS table.
+func editDistance[T comparable](a, b []T) int {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+	return len(a) + len(b) - 2*lcs[0][0]
+}
+
+func edits[T any](ops []DiffOp[T]) int {
+	n := 0
+	for _, op := range ops {
+		if op.Kind != Keep {
+			n++
+		}
+	}
+	return n
+}
+
+func TestDiff(t *testing.T) {
+	a := strings.Split("ABCABBA", "")
+	b := strings.Split("CBABAC", "")
+	ops := Diff(a, b)
+	if got := edits(ops); got != 5 {
+		t.Errorf("Diff(ABCABBA, CBABAC) has %d edits, want 5", got)
+	}
+	if got := Apply(a, ops); !slices.Equal(got, b) {
+		t.Errorf("Apply(a, Diff(a, b)) = %v, want %v", got, b)
+	}
+}
+
+func TestDiffEdgeCases(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		a, b []int
+		want []DiffOp[int]
+	}{
+		{"both empty", nil, nil, nil},
+		{"insert all", nil, []int{1, 2}, []DiffOp[int]{{Insert, 1}, {Insert, 2}}},
+		{"delete all", []int{1, 2}, nil, []DiffOp[int]{{Delete, 1}, {Delete, 2}}},
+		{"equal", []int{1, 2}, []int{1, 2}, []DiffOp[int]{{Keep, 1}, {Keep, 2}}},
+	} {
+		if got := Diff(tt.a, tt.b); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: Diff = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+// TestDiffRandom checks on random inputs over a small alphabet that Diff
+// finds a shortest script and that Apply recreates b from it.
+func TestDiffRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	randomSlice := func() []byte {
+		s := make([]byte, r.Intn(12))
+		for i := range s {
+			s[i] = "abc"[r.Intn(3)]
+		}
+		return s
+	}
+	for range 500 {
+		a, b := randomSlice(), randomSlice()
+		ops := Diff(a, b)
+		if got, want := edits(ops), editDistance(a, b); got != want {
+			t.Fatalf("Diff(%q, %q) has %d edits, want %d", a, b, got, want)
+		}
+		if got := Apply(a, ops); !slices.Equal(got, b) {
+			t.Fatalf("Apply(%q, Diff) = %q, want %q", a, got, b)
+		}
+	}
+}
+
+func TestApplyPanics(t *testing.T) {
+	for name, ops := range map[string][]DiffOp[int]{
+		"mismatch":   {{Keep, 2}},
+		"unconsumed": {},
+		"too long":   {{Keep, 1}, {Delete, 1}},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: Apply did not panic", name)
+				}
+			}()
+			Apply([]int{1}, ops)
+		}()
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	a := strings.Split("a b c d e f g h i j k l m", " ")
+	b := strings.Split("a b c D e f g h i j k l m n", " ")
+	want := "@@ -2,5 +2,5 @@\n" +
+		" b\n c\n-d\n+D\n e\n f\n" +
+		"@@ -12,2 +12,3 @@\n" +
+		" l\n m\n+n\n"
+	if got := UnifiedDiff(a, b, 2); got != want {
+		t.Errorf("UnifiedDiff =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestUnifiedDiffMergesCloseHunks(t *testing.T) {
+	a := strings.Split("a b c d e", " ")
+	b := strings.Split("A b c d E", " ")
+	want := "@@ -1,5 +1,5 @@\n-a\n+A\n b\n c\n d\n-e\n+E\n"
+	if got := UnifiedDiff(a, b, 2); got != want {
+		t.Errorf("UnifiedDiff =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestUnifiedDiffEmpty(t *testing.T) {
+	if got := UnifiedDiff([]int{1, 2}, []int{1, 2}, 3); got != "" {
+		t.Errorf("UnifiedDiff of equal slices = %q, want \"\"", got)
+	}
+	if got, want := UnifiedDiff(nil, []int{1}, 3), "@@ -0,0 +1,1 @@\n+1\n"; got != want {
+		t.Errorf("UnifiedDiff(nil, [1]) = %q, want %q", got, want)
+	}
+}
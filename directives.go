@@ -0,0 +1,206 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// A directiveFunc evaluates the arguments of a comment directive at pos and
+// returns the formatted report.
+type directiveFunc func(c checkedPackage, pos token.Pos, scope *types.Scope, args string) string
+
+var directives = map[string]directiveFunc{
+	"constcheck:": constCheck,
+	"fields:":     structFields,
+	"gotodef:":    gotoDef,
+	"methoddiff:": methodDiff,
+	"methodset:":  methodSet,
+	"satisfiers:": satisfiers,
+	"scopetree:":  scopeTree,
+	"typediff:":   typeDiff,
+	"typeof:":     typeOf,
+	"usages:":     usages,
+}
+
+// nameDirectives take names, which may be quoted. The arguments of all other
+// directives are passed on unchanged, so that typeof: "hello" still sees a
+// string literal.
+var nameDirectives = map[string]bool{
+	"gotodef:": true,
+	"usages:":  true,
+}
+
+type directiveOutput struct {
+	pos    token.Pos
+	prefix string
+	args   string
+	text   string
+}
+
+// ParseDirective reports whether comment has the form "// prefix: args" and
+// returns its comma-separated arguments. Commas inside quotes, parentheses,
+// brackets and braces do not separate arguments, so expressions like
+// f(a, b) or G[K, V] stay whole. An argument that is a single quoted
+// string is unquoted, which suits directives taking names; directives
+// taking expressions should use rawDirectiveArgs.
+func ParseDirective(comment *ast.Comment, prefix string) (args []string, ok bool) {
+	parts, ok := rawDirectiveArgs(comment, prefix)
+	if !ok {
+		return nil, false
+	}
+	for _, part := range parts {
+		args = append(args, unquoteArg(part))
+	}
+	return args, true
+}
+
+// ParseKVDirective is like ParseDirective for arguments of the form
+// key=value, e.g. "// inspect: name=MyInt, scope=inner". Values may be
+// quoted. ok is false if comment does not start with prefix or if any
+// argument is not a key=value pair.
+func ParseKVDirective(comment *ast.Comment, prefix string) (map[string]string, bool) {
+	parts, ok := rawDirectiveArgs(comment, prefix)
+	if !ok {
+		return nil, false
+	}
+	pairs := make(map[string]string, len(parts))
+	for _, part := range parts {
+		if unquoteArg(part) != part {
+			return nil, false // A quoted argument is a value on its own, even if it contains =
+		}
+		key, value, found := strings.Cut(part, "=")
+		if !found {
+			return nil, false
+		}
+		pairs[strings.TrimSpace(key)] = unquoteArg(strings.TrimSpace(value))
+	}
+	return pairs, true
+}
+
+func rawDirectiveArgs(comment *ast.Comment, prefix string) ([]string, bool) {
+	text := comment.Text
+	if strings.HasPrefix(text, "/*") {
+		text = strings.TrimSuffix(strings.TrimPrefix(text, "/*"), "*/")
+	} else {
+		text = strings.TrimPrefix(text, "//")
+	}
+	text = strings.TrimSpace(text)
+	if !strings.HasPrefix(text, prefix) {
+		return nil, false
+	}
+	return splitArgs(strings.TrimPrefix(text, prefix)), true
+}
+
+// splitArgs splits s at commas that are not nested in quotes or brackets
+// and drops empty arguments.
+func splitArgs(s string) []string {
+	var args []string
+	var quote rune
+	depth, start, escaped := 0, 0, false
+	add := func(end int) {
+		if arg := strings.TrimSpace(s[start:end]); arg != "" {
+			args = append(args, arg)
+		}
+		start = end + 1
+	}
+	for i, r := range s {
+		switch {
+		case escaped:
+			escaped = false
+		case quote != 0:
+			if r == '\\' && quote != '`' {
+				escaped = true
+			} else if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'' || r == '`':
+			quote = r
+		case r == '(' || r == '[' || r == '{':
+			depth++
+		case r == ')' || r == ']' || r == '}':
+			depth--
+		case r == ',' && depth == 0:
+			add(i)
+		}
+	}
+	add(len(s))
+	return args
+}
+
+func unquoteArg(arg string) string {
+	if len(arg) > 0 && (arg[0] == '"' || arg[0] == '`') {
+		if unquoted, err := strconv.Unquote(arg); err == nil {
+			return unquoted
+		}
+	}
+	return arg
+}
+
+func runDirectives(c checkedPackage) []directiveOutput {
+	prefixes := make([]string, 0, len(directives))
+	for prefix := range directives {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+
+	var outputs []directiveOutput
+	for _, f := range c.files {
+		for _, group := range f.Comments {
+			for _, comment := range group.List {
+				outputs = append(outputs, runCommentDirectives(c, comment, prefixes)...)
+			}
+		}
+	}
+	return outputs
+}
+
+// runCommentDirectives evaluates a single comment line, so that directives
+// on consecutive lines are not merged into one comment group. A directive
+// with several arguments is evaluated once per argument.
+func runCommentDirectives(c checkedPackage, comment *ast.Comment, prefixes []string) []directiveOutput {
+	var outputs []directiveOutput
+	for _, prefix := range prefixes {
+		parse := rawDirectiveArgs
+		if nameDirectives[prefix] {
+			parse = ParseDirective
+		}
+		args, ok := parse(comment, prefix)
+		if !ok {
+			continue
+		}
+		if len(args) == 0 {
+			args = []string{""} // Directives like scopetree: take no arguments
+		}
+		pos := comment.Pos()
+		scope := c.pkg.Scope().Innermost(pos)
+		for _, arg := range args {
+			outputs = append(outputs, directiveOutput{
+				pos:    pos,
+				prefix: prefix,
+				args:   arg,
+				text:   directives[prefix](c, pos, scope, arg),
+			})
+		}
+	}
+	return outputs
+}
+
+func printDirective(fset *token.FileSet, out directiveOutput) error {
+	if *output == "json" {
+		return jsonLines.Encode(os.Stdout, map[string]any{
+			"directive": strings.TrimSuffix(out.prefix, ":"),
+			"args":      out.args,
+			"pos":       fset.Position(out.pos).String(),
+			"output":    out.text,
+		})
+	}
+	fmt.Printf("%s,\t%s %q\n", fset.Position(out.pos), strings.TrimSuffix(out.prefix, ":"), out.args)
+	fmt.Println(out.text)
+	return nil
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"fmt"
+	"go/token"
+	"go/types"
+	"sort"
+	"strings"
+)
+
+type promotion struct {
+	path []string
+	obj  types.Object
+}
+
+type embedConflict struct {
+	structName string
+	name       string
+	depth      int
+	promotions []promotion
+}
+
+// embedCheck reports every field or method name that two or more embedded
+// types of a package-level struct promote at the same depth. Such selectors
+// are ambiguous and therefore not promoted at all.
+func embedCheck(c checkedPackage) []embedConflict {
+	var conflicts []embedConflict
+	scope := c.pkg.Scope()
+	for _, name := range scope.Names() {
+		tn, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok || tn.IsAlias() {
+			continue
+		}
+		st, ok := tn.Type().Underlying().(*types.Struct)
+		if !ok {
+			continue
+		}
+		for _, conflict := range structConflicts(st) {
+			// Cross-check with go/types: an ambiguous selector yields no object but an index.
+			obj, index, _ := types.LookupFieldOrMethod(tn.Type(), true, c.pkg, conflict.name)
+			if obj == nil && index != nil {
+				conflict.structName = name
+				conflicts = append(conflicts, conflict)
+			}
+		}
+	}
+	return conflicts
+}
+
+// structConflicts walks the embedded fields of st breadth-first and records
+// for every name the promotions found at the shallowest depth.
+func structConflicts(st *types.Struct) []embedConflict {
+	shadowed := make(map[string]bool)
+	for i := range st.NumFields() {
+		shadowed[st.Field(i).Name()] = true
+	}
+
+	type embedding struct {
+		path []string
+		t    types.Type
+	}
+	var level []embedding
+	for i := range st.NumFields() {
+		if f := st.Field(i); f.Embedded() {
+			level = append(level, embedding{path: []string{f.Name()}, t: f.Type()})
+		}
+	}
+
+	var conflicts []embedConflict
+	seen := make(map[types.Type]bool) // Types visited at shallower depths, to stop on recursive embedding
+	for depth := 1; len(level) > 0; depth++ {
+		found := make(map[string][]promotion)
+		var next []embedding
+		for _, e := range level {
+			t := e.t
+			if p, ok := t.Underlying().(*types.Pointer); ok {
+				t = p.Elem()
+			}
+			if seen[t] {
+				continue
+			}
+
+			// Interfaces carry their methods, including those of embedded
+			// interfaces, on the underlying type. Methods of embedded structs
+			// are found at the next depth.
+			if iface, ok := t.Underlying().(*types.Interface); ok {
+				for i := range iface.NumMethods() {
+					m := iface.Method(i)
+					found[m.Name()] = append(found[m.Name()], promotion{path: e.path, obj: m})
+				}
+			} else if named, ok := t.(*types.Named); ok {
+				for i := range named.NumMethods() {
+					m := named.Method(i)
+					found[m.Name()] = append(found[m.Name()], promotion{path: e.path, obj: m})
+				}
+			}
+			if inner, ok := t.Underlying().(*types.Struct); ok {
+				for i := range inner.NumFields() {
+					f := inner.Field(i)
+					found[f.Name()] = append(found[f.Name()], promotion{path: e.path, obj: f})
+					if f.Embedded() {
+						path := append(append([]string(nil), e.path...), f.Name())
+						next = append(next, embedding{path: path, t: f.Type()})
+					}
+				}
+			}
+		}
+
+		for _, e := range level {
+			t := e.t
+			if p, ok := t.Underlying().(*types.Pointer); ok {
+				t = p.Elem()
+			}
+			seen[t] = true
+		}
+
+		names := make([]string, 0, len(found))
+		for name := range found {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if shadowed[name] {
+				continue
+			}
+			shadowed[name] = true // Deeper declarations are hidden by this depth
+			if promotions := found[name]; len(promotions) > 1 {
+				conflicts = append(conflicts, embedConflict{name: name, depth: depth, promotions: promotions})
+			}
+		}
+		level = next
+	}
+	return conflicts
+}
+
+func formatEmbedConflict(fset *token.FileSet, conflict embedConflict) string {
+	buff := &strings.Builder{}
+	fmt.Fprintf(buff, "%s.%s is ambiguous at depth %d\n", conflict.structName, conflict.name, conflict.depth)
+	for _, p := range conflict.promotions {
+		fmt.Fprintf(buff, "\t%s.%s\t%s\n", strings.Join(p.path, "."), p.obj.Name(), fset.Position(p.obj.Pos()))
+	}
+	return buff.String()
+}
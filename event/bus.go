@@ -0,0 +1,173 @@
+package event
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+
+	"projektarbeit-go-generics/collections"
+)
+
+type busSub[T any] struct {
+	id      int
+	handler func(T)
+}
+
+type delivery[T any] struct {
+	subs []busSub[T]
+	v    T
+}
+
+// EventBus delivers values to the handlers subscribed to a topic. Values
+// can be published synchronously or handed to a fixed pool of worker
+// goroutines. It is safe for concurrent use; create it with NewEventBus.
+type EventBus[T any] struct {
+	mu     sync.RWMutex // guards topics, nextID and topicOf
+	topics map[string][]busSub[T]
+	// topicOf maps subscription ids to their topic for Unsubscribe.
+	topicOf map[int]string
+	nextID  int
+
+	queueMu sync.Mutex
+	cond    *sync.Cond // signalled when deliveries are queued or the bus is closing
+	queue   collections.Queue[delivery[T]]
+	closed  bool
+	workers sync.WaitGroup
+}
+
+// NewEventBus returns a bus whose PublishAsync uses workers goroutines. It
+// panics if workers is not positive.
+func NewEventBus[T any](workers int) *EventBus[T] {
+	if workers <= 0 {
+		panic(fmt.Sprintf("event: invalid number of workers %d", workers))
+	}
+	b := &EventBus[T]{
+		topics:  make(map[string][]busSub[T]),
+		topicOf: make(map[int]string),
+	}
+	b.cond = sync.NewCond(&b.queueMu)
+	b.workers.Add(workers)
+	for range workers {
+		go b.work()
+	}
+	return b
+}
+
+func (b *EventBus[T]) work() {
+	defer b.workers.Done()
+	for {
+		b.queueMu.Lock()
+		for b.queue.Len() == 0 && !b.closed {
+			b.cond.Wait()
+		}
+		d, ok := b.queue.Dequeue()
+		b.queueMu.Unlock()
+		if !ok {
+			return // Closed and nothing left to deliver
+		}
+		deliver(d.subs, d.v)
+	}
+}
+
+func deliver[T any](subs []busSub[T], v T) {
+	for _, sub := range subs {
+		sub.handler(v)
+	}
+}
+
+// Subscribe registers handler for topic. It returns the id of the
+// subscription, which can be passed to Unsubscribe, and an idempotent
+// function that cancels it.
+func (b *EventBus[T]) Subscribe(topic string, handler func(T)) (id int, cancel func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	id = b.nextID
+	b.nextID++
+	// Append to a copy, so that snapshots taken by Publish stay valid.
+	b.topics[topic] = append(slices.Clip(b.topics[topic]), busSub[T]{id: id, handler: handler})
+	b.topicOf[id] = topic
+	return id, func() { b.Unsubscribe(id) }
+}
+
+// Unsubscribe removes the subscription with the given id and reports
+// whether it existed.
+func (b *EventBus[T]) Unsubscribe(id int) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	topic, ok := b.topicOf[id]
+	if !ok {
+		return false
+	}
+	delete(b.topicOf, id)
+	subs := slices.DeleteFunc(slices.Clone(b.topics[topic]), func(s busSub[T]) bool { return s.id == id })
+	if len(subs) == 0 {
+		delete(b.topics, topic)
+	} else {
+		b.topics[topic] = subs
+	}
+	return true
+}
+
+// Topics returns the topics with at least one subscriber, sorted.
+func (b *EventBus[T]) Topics() []string {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	topics := make([]string, 0, len(b.topics))
+	for topic := range b.topics {
+		topics = append(topics, topic)
+	}
+	slices.Sort(topics)
+	return topics
+}
+
+func (b *EventBus[T]) subscribers(topic string) []busSub[T] {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.topics[topic]
+}
+
+// Publish calls every handler subscribed to topic with v, in subscription
+// order, and returns once they are done. After DrainAndClose has been
+// called, Publish does nothing.
+func (b *EventBus[T]) Publish(topic string, v T) {
+	b.queueMu.Lock()
+	closed := b.closed
+	b.queueMu.Unlock()
+	if !closed {
+		deliver(b.subscribers(topic), v)
+	}
+}
+
+// PublishAsync queues v for delivery to the handlers currently subscribed
+// to topic and returns without waiting for them. A worker calls the
+// handlers in subscription order. The queue is unbounded, so PublishAsync
+// never blocks and no value is dropped; handlers may therefore publish
+// again themselves. After DrainAndClose has been called, PublishAsync does
+// nothing, including when called from a handler during the drain.
+func (b *EventBus[T]) PublishAsync(topic string, v T) {
+	subs := b.subscribers(topic)
+	if len(subs) == 0 {
+		return
+	}
+	b.queueMu.Lock()
+	defer b.queueMu.Unlock()
+	if !b.closed {
+		b.queue.Enqueue(delivery[T]{subs: subs, v: v})
+		b.cond.Signal()
+	}
+}
+
+// DrainAndClose stops accepting publishes, waits until all queued async
+// deliveries are done and stops the workers. Calling DrainAndClose more
+// than once has no effect.
+func (b *EventBus[T]) DrainAndClose() {
+	b.queueMu.Lock()
+	if b.closed {
+		b.queueMu.Unlock()
+		return
+	}
+	b.closed = true
+	b.cond.Broadcast()
+	b.queueMu.Unlock()
+	b.workers.Wait()
+}
@@ -0,0 +1,138 @@
+package event
+
+import (
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestPublishOrder(t *testing.T) {
+	b := NewEventBus[int](1)
+	defer b.DrainAndClose()
+	var got []string
+	b.Subscribe("a", func(v int) { got = append(got, "first") })
+	b.Subscribe("a", func(v int) { got = append(got, "second") })
+	b.Subscribe("b", func(v int) { got = append(got, "other") })
+	b.Publish("a", 1)
+	if want := []string{"first", "second"}; !slices.Equal(got, want) {
+		t.Errorf("handlers called = %v, want %v", got, want)
+	}
+}
+
+func TestUnsubscribe(t *testing.T) {
+	b := NewEventBus[int](1)
+	defer b.DrainAndClose()
+	calls := 0
+	id, _ := b.Subscribe("a", func(int) { calls++ })
+	_, cancel := b.Subscribe("b", func(int) { calls++ })
+	if !b.Unsubscribe(id) {
+		t.Errorf("Unsubscribe(%d) = false, want true", id)
+	}
+	if b.Unsubscribe(id) {
+		t.Errorf("second Unsubscribe(%d) = true, want false", id)
+	}
+	cancel()
+	cancel()
+	b.Publish("a", 1)
+	b.Publish("b", 1)
+	if calls != 0 {
+		t.Errorf("calls = %d, want 0", calls)
+	}
+	if topics := b.Topics(); len(topics) != 0 {
+		t.Errorf("Topics() = %v, want none", topics)
+	}
+}
+
+// TestConcurrentPublishSubscribe publishes from many goroutines while
+// others subscribe and checks that every handler that was subscribed
+// before publishing started sees every value.
+func TestConcurrentPublishSubscribe(t *testing.T) {
+	const publishers, perPublisher, subscribers = 8, 200, 4
+	b := NewEventBus[int](3)
+
+	var received [subscribers]atomic.Int64
+	for i := range subscribers {
+		b.Subscribe("topic", func(int) { received[i].Add(1) })
+	}
+
+	var wg sync.WaitGroup
+	for p := range publishers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range perPublisher {
+				if (p+i)%2 == 0 {
+					b.PublishAsync("topic", i)
+				} else {
+					b.Publish("topic", i)
+				}
+			}
+		}()
+	}
+	for range publishers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, cancel := b.Subscribe("topic", func(int) {})
+			cancel()
+		}()
+	}
+	wg.Wait()
+	b.DrainAndClose()
+
+	for i := range received {
+		if got := received[i].Load(); got != publishers*perPublisher {
+			t.Errorf("subscriber %d received %d values, want %d", i, got, publishers*perPublisher)
+		}
+	}
+}
+
+// TestPublishAsyncFromHandler checks that handlers can publish again while
+// the workers are busy and DrainAndClose is waiting.
+func TestPublishAsyncFromHandler(t *testing.T) {
+	b := NewEventBus[int](1)
+	var count atomic.Int64
+	b.Subscribe("topic", func(v int) {
+		count.Add(1)
+		if v > 0 {
+			b.PublishAsync("topic", v-1)
+			b.PublishAsync("topic", v-1)
+		}
+	})
+	b.PublishAsync("topic", 3)
+	b.DrainAndClose()
+	// The drain stops accepting publishes, so not all 15 values need to
+	// arrive, but DrainAndClose must return.
+	if got := count.Load(); got < 1 || got > 15 {
+		t.Errorf("handler called %d times, want between 1 and 15", got)
+	}
+}
+
+func TestDrainAndClose(t *testing.T) {
+	b := NewEventBus[int](2)
+	var count atomic.Int64
+	b.Subscribe("topic", func(int) { count.Add(1) })
+	for i := range 100 {
+		b.PublishAsync("topic", i)
+	}
+	b.DrainAndClose()
+	if got := count.Load(); got != 100 {
+		t.Errorf("delivered %d values before close, want 100", got)
+	}
+	b.Publish("topic", 1)
+	b.PublishAsync("topic", 1)
+	b.DrainAndClose()
+	if got := count.Load(); got != 100 {
+		t.Errorf("delivered %d values after close, want 100", got)
+	}
+}
+
+func TestNewEventBusPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("NewEventBus(0) did not panic")
+		}
+	}()
+	NewEventBus[int](0)
+}
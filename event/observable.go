@@ -0,0 +1,119 @@
+// Package event provides generic, typed publish-subscribe primitives.
+package event
+
+import "sync"
+
+type subscription[T any] struct {
+	handler func(T)
+}
+
+// Observable calls its subscribed handlers whenever a value is emitted. The
+// zero value is ready to use. An Observable is not safe for concurrent use;
+// see SafeObservable.
+type Observable[T any] struct {
+	subs []*subscription[T]
+}
+
+// Subscribe registers handler and returns a function that removes it again.
+// Calling cancel more than once has no effect.
+func (o *Observable[T]) Subscribe(handler func(T)) (cancel func()) {
+	sub := &subscription[T]{handler: handler}
+	o.subs = append(o.subs, sub)
+	return func() { o.remove(sub) }
+}
+
+// SubscribeOnce registers handler for the next emitted value only.
+func (o *Observable[T]) SubscribeOnce(handler func(T)) {
+	var cancel func()
+	cancel = o.Subscribe(func(v T) {
+		cancel()
+		handler(v)
+	})
+}
+
+func (o *Observable[T]) remove(sub *subscription[T]) {
+	for i, s := range o.subs {
+		if s == sub {
+			o.subs = append(o.subs[:i:i], o.subs[i+1:]...)
+			return
+		}
+	}
+}
+
+// Emit calls every handler with v in subscription order. Handlers added or
+// removed during Emit take effect from the next call.
+func (o *Observable[T]) Emit(v T) {
+	for _, sub := range o.subs {
+		sub.handler(v)
+	}
+}
+
+// Subscribers returns the number of registered handlers.
+func (o *Observable[T]) Subscribers() int {
+	return len(o.subs)
+}
+
+// Map returns an Observable that emits f(v) for every value v emitted by obs.
+func Map[T, U any](obs *Observable[T], f func(T) U) *Observable[U] {
+	mapped := &Observable[U]{}
+	obs.Subscribe(func(v T) { mapped.Emit(f(v)) })
+	return mapped
+}
+
+// SafeObservable is an Observable that may be used from multiple goroutines.
+// Handlers are called without holding the lock, so they may subscribe or
+// cancel themselves. The zero value is ready to use.
+type SafeObservable[T any] struct {
+	mu  sync.RWMutex
+	obs Observable[T]
+}
+
+// Subscribe registers handler and returns an idempotent cancel function.
+func (o *SafeObservable[T]) Subscribe(handler func(T)) (cancel func()) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	cancelUnsafe := o.obs.Subscribe(handler)
+	return func() {
+		o.mu.Lock()
+		defer o.mu.Unlock()
+		cancelUnsafe()
+	}
+}
+
+// SubscribeOnce registers handler for the next emitted value only.
+func (o *SafeObservable[T]) SubscribeOnce(handler func(T)) {
+	var once sync.Once
+	var cancel func()
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	cancelUnsafe := o.obs.Subscribe(func(v T) {
+		once.Do(func() {
+			cancel()
+			handler(v)
+		})
+	})
+	cancel = func() {
+		o.mu.Lock()
+		defer o.mu.Unlock()
+		cancelUnsafe()
+	}
+}
+
+// Emit calls every handler with v in subscription order.
+func (o *SafeObservable[T]) Emit(v T) {
+	o.mu.RLock()
+	subs := o.obs.subs // remove never modifies the slice in place, so this snapshot stays valid
+	o.mu.RUnlock()
+
+	for _, sub := range subs {
+		sub.handler(v)
+	}
+}
+
+// Subscribers returns the number of registered handlers.
+func (o *SafeObservable[T]) Subscribers() int {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.obs.Subscribers()
+}
@@ -16,6 +16,9 @@ func main() {
 	x = MyInt(43)
 	s := MyStruct{Field1: "hello", Field2: 10}
 	// inspect: MyStruct, 1, s, s.Field1
+	// gotodef: s.Field1
+	// usages: isEven
 	_ = x
+	_ = isEven(x)
 	_ = s
 }
@@ -0,0 +1,52 @@
+package main
+
+import "fmt"
+
+type MyInt int
+
+type Number interface {
+	~int | ~int64 | ~float64
+}
+
+type A struct {
+	X int
+}
+
+func (A) F() {}
+
+type B struct {
+	Y string
+}
+
+func (B) F() {}
+
+type C struct {
+	A
+	B
+}
+
+func double[T Number](v T) T {
+	// satisfiers: Number
+	return v * 2
+}
+
+// methodset: C
+// fields: C
+// typediff: A C
+// methoddiff: A C
+func main() {
+	x := MyInt(42)
+	// constcheck: MyInt satisfies fmt.Stringer
+	// constcheck: MyInt satisfies Number
+	// typeof: MyInt(42) + MyInt(1)
+	// typeof: x * 2
+	// usages: MyInt
+	// gotodef: double
+	// gotodef: fmt.Println
+	// gotodef: len
+	if y := x * 2; y > 0 {
+		// scopetree:
+		fmt.Println(y)
+	}
+	fmt.Println(x)
+}
@@ -0,0 +1,26 @@
+package main
+
+type customInt[T int | int8 | int16 | int32 | int64] struct {
+	value T
+}
+
+type myInt int
+
+type tildeInt[T ~int] struct {
+	value T
+}
+
+func main() {
+	x := customInt[int32]{value: 42}
+	y := tildeInt[myInt]{value: 1}
+	// inspect: customInt, tildeInt, x
+	_ = x
+	_ = y
+}
+
+func first[T any](xs []T) T {
+	return xs[0]
+}
+
+var _ = first([]string{"a"})
+var _ = first[customInt[int8]]
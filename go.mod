@@ -0,0 +1,3 @@
+module projektarbeit-go-generics
+
+go 1.23
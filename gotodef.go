@@ -0,0 +1,88 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+)
+
+// BuildDeclMap maps the position of every identifier to the position of
+// the declaration it denotes. Declaring identifiers map to themselves.
+// Objects without source, such as those of the universe scope or of
+// packages imported from export data without position information, map to
+// the zero Position. Keys only hold the file name, line and column, so they
+// can be looked up from a file:line:col string.
+func BuildDeclMap(fset *token.FileSet, info *types.Info) map[token.Position]token.Position {
+	decls := make(map[token.Position]token.Position, len(info.Defs)+len(info.Uses))
+	add := func(id *ast.Ident, obj types.Object) {
+		key := fset.Position(id.Pos())
+		key.Offset = 0
+		decls[key] = fset.Position(obj.Pos())
+	}
+	for id, obj := range info.Defs {
+		if obj != nil {
+			add(id, obj)
+		}
+	}
+	for id, obj := range info.Uses {
+		add(id, obj)
+	}
+	return decls
+}
+
+// parsePosition parses a file:line:col position as printed by
+// token.Position.String.
+func parsePosition(s string) (token.Position, bool) {
+	rest, col, ok := cutLastInt(s)
+	if !ok {
+		return token.Position{}, false
+	}
+	file, line, ok := cutLastInt(rest)
+	if !ok || file == "" {
+		return token.Position{}, false
+	}
+	return token.Position{Filename: file, Line: line, Column: col}, true
+}
+
+func cutLastInt(s string) (string, int, bool) {
+	i := strings.LastIndexByte(s, ':')
+	if i < 0 {
+		return "", 0, false
+	}
+	n, err := strconv.Atoi(s[i+1:])
+	return s[:i], n, err == nil && n > 0
+}
+
+// gotoDef prints where the named object, or the identifier at a
+// file:line:col position, is declared.
+func gotoDef(c checkedPackage, pos token.Pos, scope *types.Scope, args string) string {
+	name := strings.TrimSpace(args)
+	if p, ok := parsePosition(name); ok {
+		decl, ok := BuildDeclMap(c.fset, c.info)[p]
+		switch {
+		case !ok:
+			return fmt.Sprintf("\t<no identifier at %s>\n", name)
+		case !decl.IsValid():
+			return fmt.Sprintf("\t<identifier at %s is declared without source position>\n", name)
+		}
+		return fmt.Sprintf("\t%s\n", decl)
+	}
+
+	obj, _ := lookupSelector(c.pkg, scope, pos, name)
+	if obj == nil {
+		return fmt.Sprintf("\t<%s not found>\n", name)
+	}
+	p := c.fset.Position(obj.Pos())
+	switch {
+	case obj.Pkg() == nil:
+		return fmt.Sprintf("\t<%s is predeclared>\n", name)
+	case !p.IsValid():
+		return fmt.Sprintf("\t<%s is declared in package %s without source position>\n", name, obj.Pkg().Path())
+	case obj.Pkg() != c.pkg:
+		return fmt.Sprintf("\t%s (package %s)\n", p, obj.Pkg().Path())
+	}
+	return fmt.Sprintf("\t%s\n", p)
+}
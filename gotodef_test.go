@@ -0,0 +1,55 @@
+package main
+
+import (
+	"go/token"
+	"strings"
+	"testing"
+)
+
+func TestBuildDeclMap(t *testing.T) {
+	c := checkSource(t, readExample(t, "example1.go.e"))
+	decls := BuildDeclMap(c.fset, c.info)
+	for _, tt := range []struct {
+		use  token.Position
+		want string
+	}{
+		{token.Position{Filename: "input.go", Line: 22, Column: 6}, "input.go:5:6"},   // isEven(x)
+		{token.Position{Filename: "input.go", Line: 22, Column: 13}, "input.go:15:2"}, // x
+		{token.Position{Filename: "input.go", Line: 17, Column: 16}, "input.go:10:2"}, // Field1 in the literal
+		{token.Position{Filename: "input.go", Line: 5, Column: 6}, "input.go:5:6"},    // declaration
+	} {
+		if got, ok := decls[tt.use]; !ok || got.String() != tt.want {
+			t.Errorf("BuildDeclMap()[%s] = %s, %v, want %s", tt.use, got, ok, tt.want)
+		}
+	}
+	// bool is declared in the universe scope, which has no source.
+	if got, ok := decls[token.Position{Filename: "input.go", Line: 5, Column: 22}]; !ok || got.IsValid() {
+		t.Errorf("BuildDeclMap()[bool] = %s, %v, want the zero Position", got, ok)
+	}
+}
+
+func TestGotoDefDirective(t *testing.T) {
+	// The five added lines move the use of x in isEven(x) to line 27.
+	src := strings.Replace(readExample(t, "example1.go.e"), "// gotodef: s.Field1",
+		"// gotodef: s.Field1\n\t// gotodef: input.go:27:13\n\t// gotodef: input.go:5:22\n\t// gotodef: input.go:1:1\n\t// gotodef: len\n\t// gotodef: missing", 1)
+	out := inspect(t, src)
+	for _, want := range []string{
+		"\tinput.go:10:2\n",
+		"\tinput.go:15:2\n",
+		"\t<identifier at input.go:5:22 is declared without source position>\n",
+		"\t<no identifier at input.go:1:1>\n",
+		"\t<len is predeclared>\n",
+		"\t<missing not found>\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output does not contain %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestGotoDefImported(t *testing.T) {
+	out := inspect(t, "package main\n\nimport \"fmt\"\n\n// gotodef: fmt.Println\nfunc main() { fmt.Println() }\n")
+	if !strings.Contains(out, "package fmt") {
+		t.Errorf("output does not name package fmt:\n%s", out)
+	}
+}
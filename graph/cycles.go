@@ -0,0 +1,41 @@
+package graph
+
+// Cycles returns every elementary cycle of the graph, each starting at its
+// earliest added node, e.g. [a b c] for a → b → c → a. A self-loop is a
+// cycle of one node. The number of cycles can grow exponentially with the
+// size of the graph, so this is meant for sparse graphs like dependency
+// graphs.
+func (g *Graph[N, E]) Cycles() [][]N {
+	index := make(map[N]int, len(g.nodes))
+	for i, n := range g.nodes {
+		index[n] = i
+	}
+
+	var cycles [][]N
+	for _, start := range g.nodes {
+		onPath := map[N]bool{start: true}
+		path := []N{start}
+		var walk func(n N)
+		walk = func(n N) {
+			targets := make(map[N]bool) // Parallel edges would repeat cycles
+			for _, e := range g.adjacency[n] {
+				if targets[e.To] {
+					continue
+				}
+				targets[e.To] = true
+				switch {
+				case e.To == start:
+					cycles = append(cycles, append([]N(nil), path...))
+				case index[e.To] > index[start] && !onPath[e.To]:
+					onPath[e.To] = true
+					path = append(path, e.To)
+					walk(e.To)
+					path = path[:len(path)-1]
+					onPath[e.To] = false
+				}
+			}
+		}
+		walk(start)
+	}
+	return cycles
+}
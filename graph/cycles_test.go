@@ -0,0 +1,49 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCyclesSelfLoop(t *testing.T) {
+	g := NewGraph[string, int]()
+	g.AddEdge("a", "a", 0)
+	g.AddEdge("a", "b", 0)
+	if got, want := g.Cycles(), [][]string{{"a"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Cycles() = %v, want %v", got, want)
+	}
+}
+
+func TestCyclesParallelEdges(t *testing.T) {
+	g := NewGraph[string, int]()
+	g.AddEdge("a", "b", 1)
+	g.AddEdge("a", "b", 2)
+	g.AddEdge("b", "a", 3)
+	g.AddEdge("b", "a", 4)
+	if got, want := g.Cycles(), [][]string{{"a", "b"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Cycles() = %v, want %v", got, want)
+	}
+}
+
+func TestCyclesOverlapping(t *testing.T) {
+	// a → b → c → a and b → d → b share the node b.
+	g := NewGraph[string, int]()
+	g.AddEdge("a", "b", 0)
+	g.AddEdge("b", "c", 0)
+	g.AddEdge("c", "a", 0)
+	g.AddEdge("b", "d", 0)
+	g.AddEdge("d", "b", 0)
+	if got, want := g.Cycles(), [][]string{{"a", "b", "c"}, {"b", "d"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Cycles() = %v, want %v", got, want)
+	}
+}
+
+func TestCyclesAcyclic(t *testing.T) {
+	g := NewGraph[string, int]()
+	g.AddEdge("a", "b", 0)
+	g.AddEdge("b", "c", 0)
+	g.AddEdge("a", "c", 0)
+	if got := g.Cycles(); len(got) != 0 {
+		t.Errorf("Cycles() = %v, want none", got)
+	}
+}
@@ -0,0 +1,190 @@
+// Package graph provides a generic directed graph.
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"projektarbeit-go-generics/collections"
+)
+
+var (
+	// ErrCycle is returned by TopologicalSort if the graph contains a cycle.
+	ErrCycle = errors.New("graph: cycle detected")
+	// ErrNoPath is returned by ShortestPath if the target is unreachable.
+	ErrNoPath = errors.New("graph: no path")
+)
+
+// Edge is a directed edge to the node To carrying the value Value.
+type Edge[N comparable, E any] struct {
+	To    N
+	Value E
+}
+
+// Graph is a directed graph with nodes of type N and edge values of type E.
+// Nodes are visited in the order they were added, which keeps traversals
+// deterministic.
+type Graph[N comparable, E any] struct {
+	adjacency map[N][]Edge[N, E]
+	nodes     []N
+}
+
+// NewGraph returns an empty graph.
+func NewGraph[N comparable, E any]() *Graph[N, E] {
+	return &Graph[N, E]{adjacency: make(map[N][]Edge[N, E])}
+}
+
+// AddNode adds n to the graph if it is not already present.
+func (g *Graph[N, E]) AddNode(n N) {
+	if _, ok := g.adjacency[n]; ok {
+		return
+	}
+	g.adjacency[n] = nil
+	g.nodes = append(g.nodes, n)
+}
+
+// AddEdge adds an edge from one node to another, adding missing nodes.
+func (g *Graph[N, E]) AddEdge(from, to N, value E) {
+	g.AddNode(from)
+	g.AddNode(to)
+	g.adjacency[from] = append(g.adjacency[from], Edge[N, E]{To: to, Value: value})
+}
+
+// Nodes returns all nodes in insertion order.
+func (g *Graph[N, E]) Nodes() []N {
+	return slices.Clone(g.nodes)
+}
+
+// Edges returns the outgoing edges of n.
+func (g *Graph[N, E]) Edges(n N) []Edge[N, E] {
+	return g.adjacency[n]
+}
+
+// Neighbors returns the targets of the outgoing edges of n.
+func (g *Graph[N, E]) Neighbors(n N) []N {
+	edges := g.adjacency[n]
+	neighbors := make([]N, len(edges))
+	for i, e := range edges {
+		neighbors[i] = e.To
+	}
+	return neighbors
+}
+
+// BFS visits every node reachable from start in breadth-first order.
+func (g *Graph[N, E]) BFS(start N, visit func(N)) {
+	if _, ok := g.adjacency[start]; !ok {
+		return
+	}
+	var queue collections.Queue[N]
+	seen := map[N]bool{start: true}
+	queue.Enqueue(start)
+	for n, ok := queue.Dequeue(); ok; n, ok = queue.Dequeue() {
+		visit(n)
+		for _, e := range g.adjacency[n] {
+			if !seen[e.To] {
+				seen[e.To] = true
+				queue.Enqueue(e.To)
+			}
+		}
+	}
+}
+
+// DFS visits every node reachable from start in depth-first pre-order.
+func (g *Graph[N, E]) DFS(start N, visit func(N)) {
+	if _, ok := g.adjacency[start]; !ok {
+		return
+	}
+	seen := make(map[N]bool)
+	var walk func(N)
+	walk = func(n N) {
+		seen[n] = true
+		visit(n)
+		for _, e := range g.adjacency[n] {
+			if !seen[e.To] {
+				walk(e.To)
+			}
+		}
+	}
+	walk(start)
+}
+
+// TopologicalSort returns the nodes ordered so that every edge points from
+// an earlier to a later node. It returns ErrCycle if no such order exists.
+func (g *Graph[N, E]) TopologicalSort() ([]N, error) {
+	inDegree := make(map[N]int, len(g.nodes))
+	for _, n := range g.nodes {
+		for _, e := range g.adjacency[n] {
+			inDegree[e.To]++
+		}
+	}
+	var queue collections.Queue[N]
+	for _, n := range g.nodes {
+		if inDegree[n] == 0 {
+			queue.Enqueue(n)
+		}
+	}
+
+	order := make([]N, 0, len(g.nodes))
+	for n, ok := queue.Dequeue(); ok; n, ok = queue.Dequeue() {
+		order = append(order, n)
+		for _, e := range g.adjacency[n] {
+			inDegree[e.To]--
+			if inDegree[e.To] == 0 {
+				queue.Enqueue(e.To)
+			}
+		}
+	}
+	if len(order) != len(g.nodes) {
+		return nil, ErrCycle
+	}
+	return order, nil
+}
+
+type distance[N any] struct {
+	node N
+	dist float64
+}
+
+// ShortestPath finds the path from one node to another with the smallest
+// total weight using Dijkstra's algorithm. Weights must not be negative.
+func (g *Graph[N, E]) ShortestPath(from, to N, weight func(E) float64) ([]N, float64, error) {
+	dist := map[N]float64{from: 0}
+	prev := make(map[N]N)
+	done := make(map[N]bool)
+	pq := collections.NewPriorityQueue(func(a, b distance[N]) bool { return a.dist < b.dist })
+	pq.Push(distance[N]{node: from, dist: 0})
+
+	for d, ok := pq.Pop(); ok; d, ok = pq.Pop() {
+		if done[d.node] {
+			continue
+		}
+		done[d.node] = true
+		if d.node == to {
+			break
+		}
+		for _, e := range g.adjacency[d.node] {
+			w := weight(e.Value)
+			if w < 0 {
+				return nil, 0, fmt.Errorf("graph: negative edge weight %v from %v to %v", w, d.node, e.To)
+			}
+			if old, ok := dist[e.To]; !ok || d.dist+w < old {
+				dist[e.To] = d.dist + w
+				prev[e.To] = d.node
+				pq.Push(distance[N]{node: e.To, dist: d.dist + w})
+			}
+		}
+	}
+
+	total, ok := dist[to]
+	if !ok {
+		return nil, 0, ErrNoPath
+	}
+	path := []N{to}
+	for n := to; n != from; {
+		n = prev[n]
+		path = append(path, n)
+	}
+	slices.Reverse(path)
+	return path, total, nil
+}
@@ -0,0 +1,101 @@
+package graph
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// diamond returns a → b → d and a → c → d with string node names, as used
+// for the objects of the inspect tool.
+func diamond() *Graph[string, float64] {
+	g := NewGraph[string, float64]()
+	g.AddEdge("a", "b", 1)
+	g.AddEdge("a", "c", 4)
+	g.AddEdge("b", "d", 5)
+	g.AddEdge("c", "d", 1)
+	return g
+}
+
+func TestAddNode(t *testing.T) {
+	g := NewGraph[int, struct{}]()
+	g.AddNode(2)
+	g.AddNode(1)
+	g.AddNode(2)
+	g.AddEdge(1, 3, struct{}{})
+	if got, want := g.Nodes(), []int{2, 1, 3}; !slices.Equal(got, want) {
+		t.Errorf("Nodes() = %v, want %v", got, want)
+	}
+	if got := g.Neighbors(1); !slices.Equal(got, []int{3}) {
+		t.Errorf("Neighbors(1) = %v, want [3]", got)
+	}
+	if got := g.Neighbors(4); len(got) != 0 {
+		t.Errorf("Neighbors of a missing node = %v, want none", got)
+	}
+}
+
+func TestBFSDFS(t *testing.T) {
+	g := diamond()
+	g.AddEdge("b", "e", 0)
+	var bfs, dfs []string
+	g.BFS("a", func(n string) { bfs = append(bfs, n) })
+	g.DFS("a", func(n string) { dfs = append(dfs, n) })
+	if want := []string{"a", "b", "c", "d", "e"}; !slices.Equal(bfs, want) {
+		t.Errorf("BFS = %v, want %v", bfs, want)
+	}
+	if want := []string{"a", "b", "d", "e", "c"}; !slices.Equal(dfs, want) {
+		t.Errorf("DFS = %v, want %v", dfs, want)
+	}
+	g.BFS("missing", func(n string) { t.Errorf("BFS from a missing node visited %s", n) })
+	g.DFS("missing", func(n string) { t.Errorf("DFS from a missing node visited %s", n) })
+}
+
+func TestTopologicalSort(t *testing.T) {
+	order, err := diamond().TopologicalSort()
+	if want := []string{"a", "b", "c", "d"}; err != nil || !slices.Equal(order, want) {
+		t.Errorf("TopologicalSort() = %v, %v, want %v, nil", order, err, want)
+	}
+
+	g := NewGraph[int, struct{}]()
+	g.AddEdge(1, 2, struct{}{})
+	g.AddEdge(2, 3, struct{}{})
+	g.AddEdge(3, 2, struct{}{})
+	if _, err := g.TopologicalSort(); !errors.Is(err, ErrCycle) {
+		t.Errorf("TopologicalSort() of a cyclic graph error = %v, want %v", err, ErrCycle)
+	}
+}
+
+func TestShortestPath(t *testing.T) {
+	weight := func(w float64) float64 { return w }
+	path, dist, err := diamond().ShortestPath("a", "d", weight)
+	if err != nil || dist != 5 || !slices.Equal(path, []string{"a", "c", "d"}) {
+		t.Errorf("ShortestPath(a, d) = %v, %v, %v, want [a c d], 5, nil", path, dist, err)
+	}
+	path, dist, err = diamond().ShortestPath("a", "a", weight)
+	if err != nil || dist != 0 || !slices.Equal(path, []string{"a"}) {
+		t.Errorf("ShortestPath(a, a) = %v, %v, %v, want [a], 0, nil", path, dist, err)
+	}
+	if _, _, err := diamond().ShortestPath("d", "a", weight); !errors.Is(err, ErrNoPath) {
+		t.Errorf("ShortestPath(d, a) error = %v, want %v", err, ErrNoPath)
+	}
+
+	g := diamond()
+	g.AddEdge("a", "e", -1)
+	if _, _, err := g.ShortestPath("a", "d", weight); err == nil {
+		t.Error("ShortestPath with a negative weight succeeded")
+	}
+}
+
+func TestShortestPathIntNodes(t *testing.T) {
+	type road struct{ km int }
+	g := NewGraph[int, road]()
+	g.AddEdge(1, 2, road{7})
+	g.AddEdge(1, 3, road{9})
+	g.AddEdge(2, 4, road{15})
+	g.AddEdge(3, 4, road{11})
+	g.AddEdge(2, 3, road{1})
+	path, dist, err := g.ShortestPath(1, 4, func(r road) float64 { return float64(r.km) })
+	if err != nil || dist != 19 || !slices.Equal(path, []int{1, 2, 3, 4}) {
+		t.Errorf("ShortestPath(1, 4) = %v, %v, %v, want [1 2 3 4], 19, nil", path, dist, err)
+	}
+}
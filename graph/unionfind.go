@@ -0,0 +1,95 @@
+package graph
+
+import (
+	"cmp"
+	"slices"
+)
+
+// UnionFind is a disjoint-set forest using union by rank and path
+// compression. The zero value is not usable; create one with NewUnionFind.
+type UnionFind[T comparable] struct {
+	parent map[T]T
+	rank   map[T]int
+	elems  []T // Insertion order, keeps Components deterministic
+}
+
+// NewUnionFind returns an empty UnionFind.
+func NewUnionFind[T comparable]() *UnionFind[T] {
+	return &UnionFind[T]{parent: make(map[T]T), rank: make(map[T]int)}
+}
+
+// Add adds x as a singleton set if it is not already present.
+func (uf *UnionFind[T]) Add(x T) {
+	if _, ok := uf.parent[x]; ok {
+		return
+	}
+	uf.parent[x] = x
+	uf.elems = append(uf.elems, x)
+}
+
+// Find returns the representative of the set containing x, adding x first
+// if it is unknown.
+func (uf *UnionFind[T]) Find(x T) T {
+	uf.Add(x)
+	root := x
+	for uf.parent[root] != root {
+		root = uf.parent[root]
+	}
+	for x != root {
+		next := uf.parent[x]
+		uf.parent[x] = root
+		x = next
+	}
+	return root
+}
+
+// Union merges the sets containing a and b.
+func (uf *UnionFind[T]) Union(a, b T) {
+	ra, rb := uf.Find(a), uf.Find(b)
+	if ra == rb {
+		return
+	}
+	switch {
+	case uf.rank[ra] < uf.rank[rb]:
+		uf.parent[ra] = rb
+	case uf.rank[ra] > uf.rank[rb]:
+		uf.parent[rb] = ra
+	default:
+		uf.parent[rb] = ra
+		uf.rank[ra]++
+	}
+}
+
+// Connected reports whether a and b are in the same set.
+func (uf *UnionFind[T]) Connected(a, b T) bool {
+	return uf.Find(a) == uf.Find(b)
+}
+
+// Components returns all sets. Components and their elements are ordered by
+// the first time an element was added.
+func (uf *UnionFind[T]) Components() [][]T {
+	index := make(map[T]int)
+	var components [][]T
+	for _, x := range uf.elems {
+		root := uf.Find(x)
+		i, ok := index[root]
+		if !ok {
+			i = len(components)
+			index[root] = i
+			components = append(components, nil)
+		}
+		components[i] = append(components[i], x)
+	}
+	return components
+}
+
+// SortedComponents is like Components but sorts every component and then
+// orders the components by their smallest element.
+func SortedComponents[T cmp.Ordered](uf *UnionFind[T]) [][]T {
+	components := uf.Components()
+	for _, c := range components {
+		slices.Sort(c)
+	}
+	slices.SortFunc(components, func(a, b []T) int { return cmp.Compare(a[0], b[0]) })
+	return components
+}
@@ -0,0 +1,101 @@
+package graph
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"reflect"
+	"testing"
+)
+
+func TestUnionFind(t *testing.T) {
+	uf := NewUnionFind[int]()
+	for i := range 6 {
+		uf.Add(i)
+	}
+	uf.Union(0, 1)
+	uf.Union(2, 3)
+	uf.Union(1, 3)
+	if !uf.Connected(0, 2) {
+		t.Error("Connected(0, 2) = false, want true")
+	}
+	if uf.Connected(0, 4) {
+		t.Error("Connected(0, 4) = true, want false")
+	}
+	if uf.Find(3) != uf.Find(0) {
+		t.Error("Find(3) and Find(0) differ")
+	}
+	if uf.Find(5) != 5 {
+		t.Errorf("Find(5) = %d, want 5", uf.Find(5))
+	}
+	want := [][]int{{0, 1, 2, 3}, {4}, {5}}
+	if got := uf.Components(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Components() = %v, want %v", got, want)
+	}
+}
+
+func TestUnionFindAddsOnFind(t *testing.T) {
+	uf := NewUnionFind[string]()
+	uf.Union("b", "a")
+	uf.Find("c")
+	if got, want := SortedComponents(uf), [][]string{{"a", "b"}, {"c"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SortedComponents() = %v, want %v", got, want)
+	}
+}
+
+func TestUnionFindPathCompression(t *testing.T) {
+	uf := NewUnionFind[int]()
+	// Union by rank keeps repeated unions with 0 from building a chain.
+	for i := 1; i < 100; i++ {
+		uf.Union(0, i)
+	}
+	root := uf.Find(99)
+	for i := range 100 {
+		uf.Find(i)
+		if uf.parent[i] != root {
+			t.Fatalf("parent of %d after Find = %d, want the root %d", i, uf.parent[i], root)
+		}
+	}
+	if uf.rank[root] > 1 {
+		t.Errorf("rank of the root = %d, want at most 1", uf.rank[root])
+	}
+}
+
+// TestEmbeddingComponents groups the named types of the directives example
+// by struct embedding: C embeds A and B, so the three form one component.
+func TestEmbeddingComponents(t *testing.T) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "../example_directives.go.e", nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf := types.Config{Importer: importer.ForCompiler(fset, "gc", nil)}
+	pkg, err := conf.Check("main", fset, []*ast.File{f}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	uf := NewUnionFind[string]()
+	for _, name := range pkg.Scope().Names() {
+		tn, ok := pkg.Scope().Lookup(name).(*types.TypeName)
+		if !ok {
+			continue
+		}
+		uf.Add(name)
+		st, ok := tn.Type().Underlying().(*types.Struct)
+		if !ok {
+			continue
+		}
+		for i := range st.NumFields() {
+			if field := st.Field(i); field.Embedded() {
+				uf.Union(name, types.TypeString(field.Type(), types.RelativeTo(pkg)))
+			}
+		}
+	}
+	want := [][]string{{"A", "B", "C"}, {"MyInt"}, {"Number"}}
+	if got := SortedComponents(uf); !reflect.DeepEqual(got, want) {
+		t.Errorf("SortedComponents() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"projektarbeit-go-generics/graph"
+)
+
+// ImportEdge is an import of the package Path. Test is set for imports that
+// only test files of the importing package declare.
+type ImportEdge struct {
+	Path string
+	Test bool
+}
+
+type listedPackage struct {
+	ImportPath   string
+	DepOnly      bool
+	Imports      []string
+	TestImports  []string
+	XTestImports []string
+	Error        *struct{ Err string }
+}
+
+// BuildImportGraph loads the package in dir and all its dependencies with
+// go list and returns the graph of their imports. Test imports are only
+// recorded for the package in dir, since go list does not load the tests of
+// dependencies.
+func BuildImportGraph(dir string) (*graph.Graph[string, ImportEdge], error) {
+	cmd := exec.Command("go", "list", "-e", "-json", "-deps", ".")
+	cmd.Dir = dir
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("go list: %v: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	g := graph.NewGraph[string, ImportEdge]()
+	dec := json.NewDecoder(bytes.NewReader(out))
+	for {
+		var p listedPackage
+		if err := dec.Decode(&p); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		// go list reports import cycles as package errors, but still lists
+		// the imports, which are exactly what DetectImportCycles needs.
+		if p.Error != nil && !isImportCycleError(p.Error.Err) {
+			return nil, fmt.Errorf("%s: %s", p.ImportPath, p.Error.Err)
+		}
+		g.AddNode(p.ImportPath)
+		for _, imp := range p.Imports {
+			g.AddEdge(p.ImportPath, imp, ImportEdge{Path: imp})
+		}
+		if p.DepOnly {
+			continue
+		}
+		// Only record imports that the non-test files do not already declare.
+		seen := map[string]bool{p.ImportPath: true}
+		for _, imp := range p.Imports {
+			seen[imp] = true
+		}
+		for _, imp := range append(p.TestImports, p.XTestImports...) {
+			if !seen[imp] {
+				seen[imp] = true
+				g.AddEdge(p.ImportPath, imp, ImportEdge{Path: imp, Test: true})
+			}
+		}
+	}
+	return g, nil
+}
+
+// isImportCycleError reports whether msg is the error go list attaches to
+// the packages of an import cycle, with or without test files involved.
+func isImportCycleError(msg string) bool {
+	return strings.HasPrefix(msg, "import cycle not allowed")
+}
+
+// DetectImportCycles returns every import cycle in g as the list of
+// packages along it.
+func DetectImportCycles(g *graph.Graph[string, ImportEdge]) [][]string {
+	return g.Cycles()
+}
+
+func printImportGraph(dir string) error {
+	g, err := BuildImportGraph(dir)
+	if err != nil {
+		return err
+	}
+	for _, n := range g.Nodes() {
+		for _, e := range g.Edges(n) {
+			if *output == "json" {
+				if err := jsonLines.Encode(os.Stdout, map[string]any{"from": n, "to": e.Value.Path, "test": e.Value.Test}); err != nil {
+					return err
+				}
+				continue
+			}
+			note := ""
+			if e.Value.Test {
+				note = " (test)"
+			}
+			fmt.Printf("%s -> %s%s\n", n, e.Value.Path, note)
+		}
+	}
+	for _, cycle := range DetectImportCycles(g) {
+		fmt.Fprintf(os.Stderr, "import cycle: %s -> %s\n", strings.Join(cycle, " -> "), cycle[0])
+	}
+	return nil
+}
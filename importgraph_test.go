@@ -0,0 +1,75 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// writeModule writes files into a new module named example in a temporary
+// directory and returns the directory.
+func writeModule(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	files["go.mod"] = "module example\n\ngo 1.21\n"
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestBuildImportGraphTestImports(t *testing.T) {
+	dir := writeModule(t, map[string]string{
+		"a.go":      "package a\n\nimport \"strings\"\n\nvar _ = strings.ToUpper\n",
+		"a_test.go": "package a\n\nimport (\n\t\"strings\"\n\t\"testing\"\n)\n\nfunc TestA(t *testing.T) { _ = strings.ToUpper }\n",
+		"x_test.go": "package a_test\n\nimport (\n\t\"example\"\n\t\"testing\"\n)\n\nfunc TestX(t *testing.T) {}\n",
+	})
+	g, err := BuildImportGraph(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []ImportEdge{{Path: "strings"}, {Path: "testing", Test: true}}
+	var got []ImportEdge
+	for _, e := range g.Edges("example") {
+		got = append(got, e.Value)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("edges of example = %v, want %v", got, want)
+	}
+	if cycles := DetectImportCycles(g); len(cycles) != 0 {
+		t.Errorf("DetectImportCycles() = %v, want none", cycles)
+	}
+}
+
+func TestBuildImportGraphCycle(t *testing.T) {
+	dir := writeModule(t, map[string]string{
+		"main.go": "package main\n\nimport _ \"example/a\"\n\nfunc main() {}\n",
+		"a/a.go":  "package a\n\nimport _ \"example/b\"\n",
+		"b/b.go":  "package b\n\nimport _ \"example/a\"\n",
+	})
+	g, err := BuildImportGraph(dir)
+	if err != nil {
+		t.Fatalf("BuildImportGraph() of a module with an import cycle: %v", err)
+	}
+	// go list prints dependencies first, so the cycle starts at example/b.
+	want := [][]string{{"example/b", "example/a"}}
+	if got := DetectImportCycles(g); !reflect.DeepEqual(got, want) {
+		t.Errorf("DetectImportCycles() = %v, want %v", got, want)
+	}
+}
+
+func TestBuildImportGraphError(t *testing.T) {
+	dir := writeModule(t, map[string]string{
+		"main.go": "package main\n\nimport _ \"example/missing\"\n\nfunc main() {}\n",
+	})
+	if _, err := BuildImportGraph(dir); err == nil {
+		t.Error("BuildImportGraph() succeeded for a missing import")
+	}
+}
@@ -8,122 +8,369 @@ import (
 	"go/parser"
 	"go/token"
 	"go/types"
+	"io"
 	"os"
-	"path/filepath"
 	"strings"
+
+	"projektarbeit-go-generics/builder"
+	"projektarbeit-go-generics/ref"
+	"projektarbeit-go-generics/result"
 )
 
 const inspectPrefix = "inspect:"
 
-func findLookupNames(commentText string) []string {
-	if !strings.HasPrefix(commentText, inspectPrefix) {
-		return nil
-	}
-	text := strings.TrimPrefix(commentText, inspectPrefix)
-	parts := strings.Split(text, ",")
-	var names []string
-	for _, part := range parts {
-		name := strings.TrimSpace(part)
-		if name != "" {
-			names = append(names, name)
+// findLookupNames returns the names to look up for an inspect: comment and
+// the scope to look them up in. Besides a plain list of names the comment
+// may use the form "inspect: name=MyInt, scope=package", where scope is
+// inner (the default), package or universe.
+func findLookupNames(c checkedPackage, comment *ast.Comment) ([]string, *types.Scope, error) {
+	scope := c.pkg.Scope().Innermost(comment.Pos()) // Find the scope closest to the comment position
+	if opts, ok := ParseKVDirective(comment, inspectPrefix); ok && opts["name"] != "" {
+		switch opts["scope"] {
+		case "", "inner":
+		case "package":
+			scope = c.pkg.Scope()
+		case "universe":
+			scope = types.Universe
+		default:
+			return nil, nil, fmt.Errorf("%s: unknown scope %q, want inner, package or universe", c.fset.Position(comment.Pos()), opts["scope"])
 		}
+		return []string{opts["name"]}, scope, nil
 	}
-	return names
+	names, _ := ParseDirective(comment, inspectPrefix)
+	return names, scope, nil
 }
 
-func formatObj(fset *token.FileSet, obj types.Object) string {
-	if obj == nil {
+func formatObj(fset *token.FileSet, found ref.Ref[types.Object]) string {
+	if !found.IsPresent() {
 		return "\t<not found>\n"
 	}
-	pos := fset.Position(obj.Pos())
+	obj := found.Value()
+	m := marshalObj(fset, obj)
 
 	buff := &strings.Builder{}
-	fmt.Fprintf(buff, "\tKind: %T\n", obj)
-	fmt.Fprintf(buff, "\tType: %s\n", obj.Type().String())
+	fmt.Fprintf(buff, "\tKind: *types.%s\n", m["kind"])
+	fmt.Fprintf(buff, "\tType: %s\n", m["type"])
 	fmt.Fprintf(buff, "\tPkg: %v\n", obj.Pkg())
-	fmt.Fprintf(buff, "\tPos: %v\n", pos)
-	if v, ok := obj.(*types.Var); ok {
-		fmt.Fprintf(buff, "\tVar isExported: %v\n", v.Exported())
+	fmt.Fprintf(buff, "\tPos: %s\n", m["pos"])
+	if _, ok := obj.(*types.Var); ok {
+		fmt.Fprintf(buff, "\tVar isExported: %v\n", m["exported"])
 	}
 	if f, ok := obj.(*types.Func); ok {
 		sig := f.Type().(*types.Signature)
 		fmt.Fprintf(buff, "\tFunc Params: %s\n", sig.Params().String())
 		fmt.Fprintf(buff, "\tFunc Results: %s\n", sig.Results().String())
+		if *short && localPkg != nil {
+			opts := FormatOpts{Name: f.Name(), ShowReceiver: true, Pkg: localPkg, TypeNames: DefaultTypeNameOptions}
+			fmt.Fprintf(buff, "\tFunc Signature: %s\n", FormatSignature(sig, fset, opts))
+		}
+	}
+	if tparams, ok := m["typeParams"].([]map[string]any); ok {
+		formatTypeParams(buff, tparams)
 	}
-	underlying := obj.Type().Underlying()
-	fmt.Fprintf(buff, "\tUnderlying Type: %T %s\n", underlying, underlying.String())
+	fmt.Fprintf(buff, "\tUnderlying Type: *types.%s %s\n", m["underlyingKind"], displayType(obj.Type().Underlying()))
 	return buff.String()
 }
 
-func printObj(fset *token.FileSet, pos token.Pos, name string, obj types.Object) {
+// localPkg is the package being inspected, used by -short.
+var localPkg *types.Package
+
+func displayType(t types.Type) string {
+	if *short && localPkg != nil {
+		return NormalizeTypeName(t, localPkg)
+	}
+	return t.String()
+}
+
+// formatTypeParams prints the type parameters as marshalled by
+// marshalTypeParams.
+func formatTypeParams(buff *strings.Builder, tparams []map[string]any) {
+	for _, tp := range tparams {
+		fmt.Fprintf(buff, "\tType Param %s: %s\n", tp["name"], tp["constraint"])
+		for _, term := range tp["terms"].([]string) {
+			fmt.Fprintf(buff, "\t\tTerm: %s\n", term)
+		}
+		if st, ok := tp["structuralType"].(string); ok {
+			fmt.Fprintf(buff, "\t\tStructural Type: %s\n", st)
+		} else {
+			fmt.Fprintf(buff, "\t\tStructural Type: <none>\n")
+		}
+	}
+}
+
+// constraintTerms collects the type terms of a constraint interface. A plain
+// embedded type like the int in interface{ int } counts as a single term.
+func constraintTerms(iface *types.Interface) []*types.Term {
+	var terms []*types.Term
+	for i := range iface.NumEmbeddeds() {
+		switch e := iface.EmbeddedType(i).(type) {
+		case *types.Union:
+			for j := range e.Len() {
+				terms = append(terms, e.Term(j))
+			}
+		case *types.Interface:
+			terms = append(terms, constraintTerms(e)...)
+		default:
+			if inner, ok := e.Underlying().(*types.Interface); ok {
+				terms = append(terms, constraintTerms(inner)...)
+			} else {
+				terms = append(terms, types.NewTerm(false, e))
+			}
+		}
+	}
+	return terms
+}
+
+// structuralType returns the underlying type shared by all terms, or nil if
+// there are no terms or their underlying types differ.
+func structuralType(terms []*types.Term) types.Type {
+	var st types.Type
+	for _, term := range terms {
+		u := term.Type().Underlying()
+		if st == nil {
+			st = u
+		} else if !types.Identical(st, u) {
+			return nil
+		}
+	}
+	return st
+}
+
+func printObj(fset *token.FileSet, pos token.Pos, name string, obj ref.Ref[types.Object], sel *selection) {
 	fmt.Printf("%s,\t%q\n", fset.Position(pos), name)
+	if sel != nil {
+		fmt.Print(formatSelection(sel))
+	}
 	fmt.Println(formatObj(fset, obj))
 }
 
-func inspectCode(code string, fileName string) {
-	fset := token.NewFileSet()
+type checkedPackage struct {
+	fset  *token.FileSet
+	files []*ast.File
+	pkg   *types.Package
+	info  *types.Info
+}
 
-	f, err := parser.ParseFile(fset, fileName, code, parser.ParseComments)
-	if err != nil {
-		panic(err)
-	}
+type lookup struct {
+	pos  token.Pos
+	name string
+	obj  ref.Ref[types.Object]
+	sel  *selection
+}
+
+type source struct {
+	fileName string
+	code     string
+}
 
-	conf := types.Config{
-		Importer: importer.Default(),
+func parseSources(fset *token.FileSet, sources []source) result.Result[[]*ast.File] {
+	files := make([]*ast.File, 0, len(sources))
+	for _, src := range sources {
+		f, err := parser.ParseFile(fset, src.fileName, src.code, parser.ParseComments)
+		if err != nil {
+			return result.Err[[]*ast.File](err)
+		}
+		files = append(files, f)
 	}
+	return result.OK(files)
+}
+
+// checkFiles type-checks all files together as a single package, so names
+// declared in one file can be looked up from comments in any other.
+func checkFiles(fset *token.FileSet, files []*ast.File) result.Result[checkedPackage] {
+	// No validator is registered, so Build cannot fail.
+	conf, _ := builder.NewBuilder(types.Config{}).
+		Apply(func(c *types.Config) { c.Importer = importer.ForCompiler(fset, "gc", nil) }).
+		Build()
 
+	// Use the package name as path, so types print as main.MyInt.
 	pkg := types.NewPackage("main", "")
+	if len(files) > 0 {
+		pkg = types.NewPackage(files[0].Name.Name, "")
+	}
 
 	info := &types.Info{
-		// Types: make(map[ast.Expr]types.TypeAndValue)
+		Types:     make(map[ast.Expr]types.TypeAndValue),
+		Defs:      make(map[*ast.Ident]types.Object),
+		Uses:      make(map[*ast.Ident]types.Object),
+		Instances: make(map[*ast.Ident]types.Instance),
 	}
 	checker := types.NewChecker(&conf, fset, pkg, info)
 
-	err = checker.Files([]*ast.File{f})
-	if err != nil {
-		panic(err)
+	if err := checker.Files(files); err != nil {
+		return result.Err[checkedPackage](err)
 	}
+	return result.OK(checkedPackage{fset: fset, files: files, pkg: pkg, info: info})
+}
 
-	for _, comment := range f.Comments {
-		names := findLookupNames(comment.Text())
-		if names == nil {
-			continue
+func lookupNames(c checkedPackage) ([]lookup, error) {
+	var lookups []lookup
+	for _, f := range c.files {
+		for _, group := range f.Comments {
+			for _, comment := range group.List {
+				names, scope, err := findLookupNames(c, comment)
+				if err != nil {
+					return nil, err
+				}
+				pos := comment.Pos()
+				for _, name := range names {
+					obj, sel := lookupSelector(c.pkg, scope, pos, name)
+					lookups = append(lookups, lookup{pos: pos, name: name, obj: ref.NonZero(obj), sel: sel})
+				}
+			}
 		}
+	}
+	return lookups, nil
+}
 
-		pos := comment.Pos()
-		scope := pkg.Scope().Innermost(pos) // Find the scope closest to the comment position
+func inspectSources(sources []source) error {
+	fset := token.NewFileSet()
 
-		for _, name := range names {
-			_, obj := scope.LookupParent(name, pos)
-			printObj(fset, pos, name, obj)
+	checked := result.AndThen(parseSources(fset, sources), func(files []*ast.File) result.Result[checkedPackage] {
+		return checkFiles(fset, files)
+	})
+	c, err := checked.TryUnwrap()
+	if err != nil {
+		return err
+	}
+	localPkg = c.pkg
+	if *dot != "" {
+		if err := writeFile(*dot, func(w io.Writer) error { return WriteScopeGraph(w, fset, c.pkg) }); err != nil {
+			return err
+		}
+	}
+	if *hierarchy != "" {
+		if err := writeFile(*hierarchy, BuildTypeHierarchy(c.pkg).WriteDOT); err != nil {
+			return err
+		}
+	}
+	if *table != "" {
+		return writeFile(*table, func(w io.Writer) error { return PrintConstraintTable(w, fset, c.pkg) })
+	}
+	if *embedcheck {
+		for _, conflict := range embedCheck(c) {
+			fmt.Println(formatEmbedConflict(fset, conflict))
+		}
+		return nil
+	}
+	if *callgraph {
+		return printCallGraph(BuildCallGraph(fset, c.info, c.files))
+	}
+	if *instances {
+		for _, inst := range ExtractInstantiations(fset, c.pkg, c.info) {
+			if *output == "json" {
+				if err := jsonLines.Encode(os.Stdout, marshalInstantiation(inst)); err != nil {
+					return err
+				}
+				continue
+			}
+			fmt.Println(formatInstantiation(inst))
+		}
+		return nil
+	}
+	lookups, err := lookupNames(c)
+	if err != nil {
+		return err
+	}
+
+	for _, l := range lookups {
+		if *output == "json" {
+			if err := printJSON(fset, l.pos, l.name, l.obj, l.sel); err != nil {
+				return err
+			}
+			continue
 		}
+		printObj(fset, l.pos, l.name, l.obj, l.sel)
 	}
+	for _, out := range runDirectives(c) {
+		if err := printDirective(fset, out); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func inspectFile(file string) {
-	data, err := os.ReadFile(file)
+// writeFile creates the file name and fills it with write.
+func writeFile(name string, write func(io.Writer) error) error {
+	f, err := os.Create(name)
 	if err != nil {
-		panic(err)
+		return err
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func inspectCode(code string, fileName string) error {
+	return inspectSources([]source{{fileName: fileName, code: code}})
+}
+
+func inspectFiles(files []string) error {
+	sources := make([]source, 0, len(files))
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		sources = append(sources, source{fileName: file, code: string(data)})
 	}
-	filename := filepath.Base(file)
-	inspectCode(string(data), filename)
+	return inspectSources(sources)
 }
 
 var file = flag.String("file", "", "Go source file to inspect")
 var code = flag.String("code", "", "Go source code to inspect")
+var output = flag.String("output", "text", "output format: text or json")
+var jsonOutput = flag.Bool("json", false, "shorthand for -output json")
+var embedcheck = flag.Bool("embedcheck", false, "report ambiguous selectors promoted from embedded fields instead of evaluating comments")
+var short = flag.Bool("short", false, "print type names relative to the inspected package")
+var dot = flag.String("dot", "", "also write the scope tree as a Graphviz DOT file to this path")
+var hierarchy = flag.String("hierarchy", "", "also write the interface implementations of the package as a Graphviz DOT file to this path")
+var imports = flag.String("imports", "", "print the import graph of the package in this directory and report import cycles")
+var table = flag.String("table", "", "write a markdown table of which types satisfy which constraints to this path instead of evaluating comments")
+var callgraph = flag.Bool("callgraph", false, "list the static call graph of the package instead of evaluating comments")
+var instances = flag.Bool("instances", false, "list all instantiations of generic functions and types instead of evaluating comments")
+
+//go:generate go run . -table constraints.md example_directives.go.e
 
 func main() {
 	flag.Parse()
 
-	if *file == "" && *code == "" {
-		fmt.Println("usage: inspect -file <file.go> OR -code '<go code>'")
+	if *imports != "" {
+		if err := printImportGraph(*imports); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
 		return
 	}
 
+	files := flag.Args()
 	if *file != "" {
-		inspectFile(*file)
+		files = append([]string{*file}, files...)
+	}
+	if len(files) == 0 && *code == "" {
+		fmt.Println("usage: inspect [-file <file.go>] [<file.go> ...] OR -code '<go code>'")
+		return
+	}
+	if *jsonOutput {
+		*output = "json"
+	}
+	if *output != "text" && *output != "json" {
+		fmt.Fprintf(os.Stderr, "unknown output format %q, expected text or json\n", *output)
+		os.Exit(2)
+	}
+
+	if len(files) > 0 {
+		if err := inspectFiles(files); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
 	}
 	if *code != "" {
-		inspectCode(*code, "input.go")
+		if err := inspectCode(*code, "input.go"); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
 	}
 }
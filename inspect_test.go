@@ -0,0 +1,135 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeTemp writes code to a new file named name in its own temporary
+// directory and returns its path.
+func writeTemp(t *testing.T, name, code string) string {
+	t.Helper()
+	f, err := os.CreateTemp(t.TempDir(), "*-"+name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString(code); err != nil {
+		t.Fatal(err)
+	}
+	return f.Name()
+}
+
+func TestInspectFilesAsOnePackage(t *testing.T) {
+	types := writeTemp(t, "a.go", "package main\n\ntype MyInt int\n")
+	uses := writeTemp(t, "b.go", "package main\n\n// inspect: MyInt\nfunc main() { var _ MyInt }\n")
+
+	out := captureStdout(t, func() error { return inspectFiles([]string{types, uses}) })
+	if !strings.Contains(out, "Pos: "+types+":3:6") {
+		t.Errorf("output does not point to MyInt in %s:\n%s", types, out)
+	}
+	if !strings.HasPrefix(out, uses+":3:1,") {
+		t.Errorf("output does not start with the comment position in %s:\n%s", uses, out)
+	}
+}
+
+func TestInspectFilesKeepsPaths(t *testing.T) {
+	// Both files are called main.go, only their directories differ.
+	first := filepath.Join(t.TempDir(), "main.go")
+	second := filepath.Join(t.TempDir(), "main.go")
+	if err := os.WriteFile(first, []byte("package main\n\ntype A int\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(second, []byte("package main\n\n// inspect: A\nfunc main() {}\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	out := captureStdout(t, func() error { return inspectFiles([]string{first, second}) })
+	if !strings.Contains(out, "Pos: "+first+":3:6") || !strings.HasPrefix(out, second+":3:1,") {
+		t.Errorf("positions do not keep the directories:\n%s", out)
+	}
+}
+
+func TestInspectFilesTypeError(t *testing.T) {
+	broken := writeTemp(t, "broken.go", "package main\n\nfunc main() { undefined() }\n")
+	if err := inspectFiles([]string{broken}); err == nil {
+		t.Error("inspectFiles succeeded on a file with a type error")
+	}
+	if err := inspectFiles([]string{filepath.Join(t.TempDir(), "missing.go")}); err == nil {
+		t.Error("inspectFiles succeeded on a missing file")
+	}
+}
+
+const typeParamSource = `package main
+
+type customInt[T int | int8 | int16 | int32 | int64] struct {
+	value T
+}
+
+type Number interface{ ~int | ~float64 }
+
+type List[E any, S ~[]E] struct{ items S }
+
+type Sum[N Number] []N
+
+// inspect: customInt, List, Sum
+func main() {}
+`
+
+func TestFormatTypeParams(t *testing.T) {
+	out := inspect(t, typeParamSource)
+	for _, want := range []string{
+		"Type Param T: int | int8 | int16 | int32 | int64\n" +
+			"\t\tTerm: int\n\t\tTerm: int8\n\t\tTerm: int16\n\t\tTerm: int32\n\t\tTerm: int64\n" +
+			"\t\tStructural Type: <none>\n",
+		"Type Param E: any\n\t\tStructural Type: <none>\n",
+		"Type Param S: ~[]E\n\t\tTerm: ~[]E\n\t\tStructural Type: []E\n",
+		"Type Param N: main.Number\n\t\tTerm: ~int\n\t\tTerm: ~float64\n\t\tStructural Type: <none>\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output does not contain\n%s\ngot:\n%s", want, out)
+		}
+	}
+}
+
+func TestFormatObjWithoutTypeParams(t *testing.T) {
+	out := inspect(t, "package main\n\ntype MyInt int\n\n// inspect: MyInt\nfunc main() {}\n")
+	if strings.Contains(out, "Type Param") {
+		t.Errorf("output of a non-generic type lists type parameters:\n%s", out)
+	}
+}
+
+func TestWriteFile(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "out.txt")
+	if err := writeFile(name, func(w io.Writer) error { _, err := io.WriteString(w, "hello\n"); return err }); err != nil {
+		t.Fatal(err)
+	}
+	if data, err := os.ReadFile(name); err != nil || string(data) != "hello\n" {
+		t.Errorf("file contains %q, %v, want %q", data, err, "hello\n")
+	}
+	failed := errors.New("write failed")
+	if err := writeFile(name, func(io.Writer) error { return failed }); err != failed {
+		t.Errorf("writeFile with a failing write = %v, want %v", err, failed)
+	}
+	if err := writeFile(filepath.Join(name, "sub"), func(io.Writer) error { return nil }); err == nil {
+		t.Error("writeFile succeeded below a regular file")
+	}
+}
+
+func TestInspectWritesFiles(t *testing.T) {
+	dir := t.TempDir()
+	for _, flagValue := range []*string{dot, hierarchy, table} {
+		defer func(flagValue *string, old string) { *flagValue = old }(flagValue, *flagValue)
+	}
+	*dot, *hierarchy, *table = filepath.Join(dir, "scopes.dot"), filepath.Join(dir, "types.dot"), filepath.Join(dir, "table.md")
+	inspect(t, "package main\n\ntype Box[T any] struct{ v T }\n\nfunc main() {}\n")
+	for _, name := range []string{*dot, *hierarchy, *table} {
+		if data, err := os.ReadFile(name); err != nil || len(data) == 0 {
+			t.Errorf("%s is empty or missing: %v", name, err)
+		}
+	}
+}
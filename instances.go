@@ -0,0 +1,61 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"sort"
+	"strings"
+)
+
+// Instantiation is a single use of a generic function or type with concrete
+// type arguments, either written out or inferred.
+type Instantiation struct {
+	Name     string
+	TypeArgs []types.Type
+	Type     types.Type // The instantiated signature or named type
+	Pos      token.Position
+}
+
+// ExtractInstantiations lists the instantiations recorded in info.Instances,
+// ordered by position. Generics declared outside pkg are qualified with the
+// name of their package.
+func ExtractInstantiations(fset *token.FileSet, pkg *types.Package, info *types.Info) []Instantiation {
+	idents := make([]*ast.Ident, 0, len(info.Instances))
+	for id := range info.Instances {
+		idents = append(idents, id)
+	}
+	sort.Slice(idents, func(i, j int) bool { return idents[i].Pos() < idents[j].Pos() })
+
+	insts := make([]Instantiation, 0, len(idents))
+	for _, id := range idents {
+		inst := info.Instances[id]
+		args := make([]types.Type, inst.TypeArgs.Len())
+		for i := range args {
+			args[i] = inst.TypeArgs.At(i)
+		}
+		name := id.Name
+		if obj := info.Uses[id]; obj != nil && obj.Pkg() != nil && obj.Pkg() != pkg {
+			name = obj.Pkg().Name() + "." + name
+		}
+		insts = append(insts, Instantiation{Name: name, TypeArgs: args, Type: inst.Type, Pos: fset.Position(id.Pos())})
+	}
+	return insts
+}
+
+func formatInstantiation(inst Instantiation) string {
+	args := make([]string, len(inst.TypeArgs))
+	for i, arg := range inst.TypeArgs {
+		args[i] = arg.String()
+	}
+	return fmt.Sprintf("%s,\t%s[%s]\n\tType: %s\n", inst.Pos, inst.Name, strings.Join(args, ", "), inst.Type)
+}
+
+func marshalInstantiation(inst Instantiation) map[string]any {
+	args := make([]string, len(inst.TypeArgs))
+	for i, arg := range inst.TypeArgs {
+		args[i] = arg.String()
+	}
+	return map[string]any{"name": inst.Name, "typeArgs": args, "type": inst.Type.String(), "pos": inst.Pos.String()}
+}
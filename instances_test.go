@@ -0,0 +1,40 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const instancesSource = `package shapes
+
+import "slices"
+
+type Box[T any] struct{ v T }
+
+func first[T any](xs []T) T { return xs[0] }
+
+var (
+	b    Box[int]
+	f    = first([]string{"a"})
+	dup  = first[Box[int]]
+	keys = slices.Index([]int{1}, 1)
+)
+`
+
+func TestExtractInstantiations(t *testing.T) {
+	c := checkSource(t, instancesSource)
+	var got []string
+	for _, inst := range ExtractInstantiations(c.fset, c.pkg, c.info) {
+		args := make([]string, len(inst.TypeArgs))
+		for i, arg := range inst.TypeArgs {
+			args[i] = arg.String()
+		}
+		got = append(got, inst.Name+"["+strings.Join(args, ", ")+"]")
+	}
+	// Local generics stay unqualified although the package is not main.
+	want := []string{"Box[int]", "first[string]", "first[shapes.Box[int]]", "Box[int]", "slices.Index[[]int, int]"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("instantiations = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,114 @@
+// Package iterator provides pull-style generic iterators and lazy adapters.
+package iterator
+
+import (
+	"iter"
+	"reflect"
+
+	"projektarbeit-go-generics/tuple"
+)
+
+// Iterator yields values one at a time. Next returns false once the
+// iterator is exhausted.
+type Iterator[T any] interface {
+	Next() (T, bool)
+}
+
+// Func adapts an ordinary function to the Iterator interface.
+type Func[T any] func() (T, bool)
+
+func (f Func[T]) Next() (T, bool) {
+	return f()
+}
+
+// SliceIter returns an iterator over the elements of s.
+func SliceIter[T any](s []T) Iterator[T] {
+	return &sliceIter[T]{s: s}
+}
+
+type sliceIter[T any] struct {
+	s []T
+	i int
+}
+
+func (it *sliceIter[T]) Next() (T, bool) {
+	if it.i >= len(it.s) {
+		var zero T
+		return zero, false
+	}
+	v := it.s[it.i]
+	it.i++
+	return v, true
+}
+
+// MapIter returns an iterator over the entries of m in unspecified order.
+func MapIter[K comparable, V any](m map[K]V) Iterator[tuple.Pair[K, V]] {
+	r := reflect.ValueOf(m).MapRange() // Walks the map lazily without collecting the keys
+	return Func[tuple.Pair[K, V]](func() (tuple.Pair[K, V], bool) {
+		if !r.Next() {
+			return tuple.Pair[K, V]{}, false
+		}
+		// Comma-ok, because a nil interface value does not assert to K or V
+		k, _ := r.Key().Interface().(K)
+		v, _ := r.Value().Interface().(V)
+		return tuple.NewPair(k, v), true
+	})
+}
+
+// FilterIter returns an iterator over the values of it for which pred
+// returns true.
+func FilterIter[T any](it Iterator[T], pred func(T) bool) Iterator[T] {
+	return Func[T](func() (T, bool) {
+		for {
+			v, ok := it.Next()
+			if !ok || pred(v) {
+				return v, ok
+			}
+		}
+	})
+}
+
+// MapIterT returns an iterator over the results of applying f to the values
+// of it.
+func MapIterT[T, U any](it Iterator[T], f func(T) U) Iterator[U] {
+	return Func[U](func() (U, bool) {
+		v, ok := it.Next()
+		if !ok {
+			var zero U
+			return zero, false
+		}
+		return f(v), true
+	})
+}
+
+// TakeIter returns an iterator over at most the first n values of it.
+func TakeIter[T any](it Iterator[T], n int) Iterator[T] {
+	return Func[T](func() (T, bool) {
+		if n <= 0 {
+			var zero T
+			return zero, false
+		}
+		n--
+		return it.Next()
+	})
+}
+
+// CollectIter drains it and returns its values as a slice.
+func CollectIter[T any](it Iterator[T]) []T {
+	var result []T
+	for v, ok := it.Next(); ok; v, ok = it.Next() {
+		result = append(result, v)
+	}
+	return result
+}
+
+// Seq adapts it to an iter.Seq so it can be used in a range loop.
+func Seq[T any](it Iterator[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v, ok := it.Next(); ok; v, ok = it.Next() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package iterator
+
+import (
+	"slices"
+	"sort"
+	"testing"
+
+	"projektarbeit-go-generics/tuple"
+)
+
+type customInt[T int | int8 | int16 | int32 | int64] struct {
+	value T
+}
+
+func TestSliceIter(t *testing.T) {
+	if got := CollectIter(SliceIter([]int{1, 2, 3})); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("CollectIter(SliceIter) = %v, want [1 2 3]", got)
+	}
+	it := SliceIter[int](nil)
+	if v, ok := it.Next(); v != 0 || ok {
+		t.Errorf("Next() on an empty iterator = %d, %v, want 0, false", v, ok)
+	}
+}
+
+func TestMapIter(t *testing.T) {
+	got := CollectIter(MapIter(map[string]int{"a": 1, "b": 2}))
+	sort.Slice(got, func(i, j int) bool { return got[i].First < got[j].First })
+	want := []tuple.Pair[string, int]{tuple.NewPair("a", 1), tuple.NewPair("b", 2)}
+	if !slices.Equal(got, want) {
+		t.Errorf("CollectIter(MapIter) = %v, want %v", got, want)
+	}
+}
+
+func TestMapIterNilInterfaces(t *testing.T) {
+	got := CollectIter(MapIter(map[any]error{nil: nil}))
+	if want := []tuple.Pair[any, error]{tuple.NewPair[any, error](nil, nil)}; !slices.Equal(got, want) {
+		t.Errorf("CollectIter(MapIter) = %v, want %v", got, want)
+	}
+}
+
+func TestAdapters(t *testing.T) {
+	even := func(v int) bool { return v%2 == 0 }
+	square := func(v int) int { return v * v }
+	it := TakeIter(MapIterT(FilterIter(SliceIter([]int{1, 2, 3, 4, 5, 6, 7, 8}), even), square), 3)
+	if got := CollectIter(it); !slices.Equal(got, []int{4, 16, 36}) {
+		t.Errorf("adapters = %v, want [4 16 36]", got)
+	}
+	if got := CollectIter(TakeIter(SliceIter([]int{1}), 0)); got != nil {
+		t.Errorf("TakeIter(0) = %v, want nothing", got)
+	}
+	if got := CollectIter(TakeIter(SliceIter([]int{1}), 5)); !slices.Equal(got, []int{1}) {
+		t.Errorf("TakeIter(5) = %v, want [1]", got)
+	}
+}
+
+func TestAdaptersAreLazy(t *testing.T) {
+	calls := 0
+	it := MapIterT(SliceIter([]int{1, 2, 3, 4}), func(v int) int {
+		calls++
+		return v
+	})
+	it = TakeIter(it, 2)
+	if calls != 0 {
+		t.Errorf("f was called %d times before iterating, want 0", calls)
+	}
+	CollectIter(it)
+	if calls != 2 {
+		t.Errorf("f was called %d times for two values, want 2", calls)
+	}
+}
+
+func TestSeq(t *testing.T) {
+	var got []string
+	for v := range Seq(SliceIter([]string{"a", "b", "c"})) {
+		if v == "c" {
+			break
+		}
+		got = append(got, v)
+	}
+	if !slices.Equal(got, []string{"a", "b"}) {
+		t.Errorf("range over Seq = %v, want [a b]", got)
+	}
+}
+
+func benchmarkInts() []customInt[int] {
+	s := make([]customInt[int], 1024)
+	for i := range s {
+		s[i] = customInt[int]{i}
+	}
+	return s
+}
+
+var sum int
+
+func BenchmarkSliceIter(b *testing.B) {
+	s := benchmarkInts()
+	for range b.N {
+		it := SliceIter(s)
+		for v, ok := it.Next(); ok; v, ok = it.Next() {
+			sum += v.value
+		}
+	}
+}
+
+func BenchmarkRangeLoop(b *testing.B) {
+	s := benchmarkInts()
+	for range b.N {
+		for _, v := range s {
+			sum += v.value
+		}
+	}
+}
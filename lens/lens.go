@@ -0,0 +1,43 @@
+// Package lens provides composable, typed accessors for nested values.
+package lens
+
+// Lens focuses on a part A of a value S. Get reads the part; Set returns a
+// copy of S with the part replaced and leaves its argument unchanged.
+type Lens[S, A any] struct {
+	Get func(S) A
+	Set func(S, A) S
+}
+
+// Compose returns a lens that focuses on the part B of the part A of S.
+func Compose[S, A, B any](outer Lens[S, A], inner Lens[A, B]) Lens[S, B] {
+	return Lens[S, B]{
+		Get: func(s S) B {
+			return inner.Get(outer.Get(s))
+		},
+		Set: func(s S, b B) S {
+			return outer.Set(s, inner.Set(outer.Get(s), b))
+		},
+	}
+}
+
+// Modify returns a copy of s with the part focused by l replaced by f of
+// its current value.
+func Modify[S, A any](l Lens[S, A], s S, f func(A) A) S {
+	return l.Set(s, f(l.Get(s)))
+}
+
+// Field builds a lens from a function returning a pointer to the part,
+// e.g. Field(func(s *S) *string { return &s.Name }). Set works on a copy of
+// S, so the lens is only immutable for struct types that do not share
+// state through pointers, slices or maps.
+func Field[S, A any](field func(*S) *A) Lens[S, A] {
+	return Lens[S, A]{
+		Get: func(s S) A {
+			return *field(&s)
+		},
+		Set: func(s S, a A) S {
+			*field(&s) = a
+			return s
+		},
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"bytes"
+	"go/token"
+	"io"
+	"os"
+	"testing"
+)
+
+// captureStdout returns everything f writes to os.Stdout.
+func captureStdout(t *testing.T, f func() error) string {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	var buf bytes.Buffer
+	copied := make(chan struct{})
+	go func() {
+		io.Copy(&buf, r)
+		close(copied)
+	}()
+	ferr := f()
+	w.Close()
+	<-copied
+	if ferr != nil {
+		t.Fatal(ferr)
+	}
+	return buf.String()
+}
+
+// inspect runs inspectCode on code as input.go and returns its output.
+func inspect(t *testing.T, code string) string {
+	t.Helper()
+	return captureStdout(t, func() error { return inspectCode(code, "input.go") })
+}
+
+// checkSource parses and type-checks code as the single file input.go.
+func checkSource(t *testing.T, code string) checkedPackage {
+	t.Helper()
+	fset := token.NewFileSet()
+	files, err := parseSources(fset, []source{{fileName: "input.go", code: code}}).TryUnwrap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := checkFiles(fset, files).TryUnwrap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+// readExample returns the contents of one of the example*.go.e files.
+func readExample(t *testing.T, name string) string {
+	t.Helper()
+	data, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
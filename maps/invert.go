@@ -0,0 +1,42 @@
+package maps
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// InvertMap returns the inverse of m, mapping each value to its key. It
+// returns an error listing the keys of every value that is not unique.
+func InvertMap[K, V comparable](m map[K]V) (map[V]K, error) {
+	multi := InvertMapMulti(m)
+	var conflicts []string
+	result := make(map[V]K, len(multi))
+	for v, keys := range multi {
+		if len(keys) > 1 {
+			names := make([]string, len(keys))
+			for i, k := range keys {
+				names[i] = fmt.Sprint(k)
+			}
+			sort.Strings(names)
+			conflicts = append(conflicts, fmt.Sprintf("%v <- [%s]", v, strings.Join(names, " ")))
+			continue
+		}
+		result[v] = keys[0]
+	}
+	if len(conflicts) > 0 {
+		sort.Strings(conflicts) // Map order is random, keep the message stable
+		return nil, fmt.Errorf("maps: values map from several keys: %s", strings.Join(conflicts, ", "))
+	}
+	return result, nil
+}
+
+// InvertMapMulti returns the inverse of m, collecting all keys that map to
+// the same value. The order of keys within each slice is unspecified.
+func InvertMapMulti[K, V comparable](m map[K]V) map[V][]K {
+	result := make(map[V][]K, len(m))
+	for k, v := range m {
+		result[v] = append(result[v], k)
+	}
+	return result
+}
@@ -0,0 +1,62 @@
+// Package maps provides generic helper functions for working with maps.
+package maps
+
+import (
+	"cmp"
+	"slices"
+
+	"projektarbeit-go-generics/tuple"
+)
+
+// Keys returns the keys of m in unspecified order.
+func Keys[K comparable, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Values returns the values of m in unspecified order.
+func Values[K comparable, V any](m map[K]V) []V {
+	values := make([]V, 0, len(m))
+	for _, v := range m {
+		values = append(values, v)
+	}
+	return values
+}
+
+// Entries returns the key-value pairs of m in unspecified order.
+func Entries[K comparable, V any](m map[K]V) []tuple.Pair[K, V] {
+	entries := make([]tuple.Pair[K, V], 0, len(m))
+	for k, v := range m {
+		entries = append(entries, tuple.NewPair(k, v))
+	}
+	return entries
+}
+
+// SortedKeys returns the keys of m in ascending order.
+func SortedKeys[K cmp.Ordered, V any](m map[K]V) []K {
+	keys := Keys(m)
+	slices.Sort(keys)
+	return keys
+}
+
+// SortedValues returns the values of m in ascending order.
+func SortedValues[K comparable, V cmp.Ordered](m map[K]V) []V {
+	values := Values(m)
+	slices.Sort(values)
+	return values
+}
+
+// FilterMap returns a new map holding f(k, v) for every entry of m for which
+// f reports true.
+func FilterMap[K comparable, V, W any](m map[K]V, f func(K, V) (W, bool)) map[K]W {
+	result := make(map[K]W)
+	for k, v := range m {
+		if w, ok := f(k, v); ok {
+			result[k] = w
+		}
+	}
+	return result
+}
@@ -0,0 +1,72 @@
+package maps
+
+import (
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"testing"
+
+	"projektarbeit-go-generics/tuple"
+)
+
+var scores = map[string]int{"carol": 3, "alice": 1, "bob": 2}
+
+func TestKeysValues(t *testing.T) {
+	keys := Keys(scores)
+	slices.Sort(keys)
+	if want := []string{"alice", "bob", "carol"}; !slices.Equal(keys, want) {
+		t.Errorf("Keys = %v, want %v", keys, want)
+	}
+	values := Values(scores)
+	slices.Sort(values)
+	if want := []int{1, 2, 3}; !slices.Equal(values, want) {
+		t.Errorf("Values = %v, want %v", values, want)
+	}
+	if cap(keys) != len(scores) || cap(values) != len(scores) {
+		t.Errorf("cap(Keys), cap(Values) = %d, %d, want %d", cap(keys), cap(values), len(scores))
+	}
+}
+
+func TestEntries(t *testing.T) {
+	entries := Entries(scores)
+	sort.Slice(entries, func(i, j int) bool { return entries[i].First < entries[j].First })
+	want := []tuple.Pair[string, int]{tuple.NewPair("alice", 1), tuple.NewPair("bob", 2), tuple.NewPair("carol", 3)}
+	if !slices.Equal(entries, want) {
+		t.Errorf("Entries = %v, want %v", entries, want)
+	}
+}
+
+func TestSorted(t *testing.T) {
+	if got, want := SortedKeys(scores), []string{"alice", "bob", "carol"}; !slices.Equal(got, want) {
+		t.Errorf("SortedKeys = %v, want %v", got, want)
+	}
+	if got, want := SortedValues(map[int]string{1: "z", 2: "a", 3: "m"}), []string{"a", "m", "z"}; !slices.Equal(got, want) {
+		t.Errorf("SortedValues = %v, want %v", got, want)
+	}
+}
+
+func TestNilMap(t *testing.T) {
+	var m map[string]int
+	if got := Keys(m); got == nil || len(got) != 0 {
+		t.Errorf("Keys(nil) = %#v, want empty slice", got)
+	}
+	if got := SortedValues(m); len(got) != 0 {
+		t.Errorf("SortedValues(nil) = %v, want empty", got)
+	}
+	if got := Entries(m); len(got) != 0 {
+		t.Errorf("Entries(nil) = %v, want empty", got)
+	}
+}
+
+func TestFilterMap(t *testing.T) {
+	got := FilterMap(scores, func(k string, v int) (string, bool) {
+		return k + strconv.Itoa(v), v%2 == 1
+	})
+	if want := map[string]string{"alice": "alice1", "carol": "carol3"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FilterMap = %v, want %v", got, want)
+	}
+	if got := FilterMap(map[int]int(nil), func(int, int) (int, bool) { return 0, true }); got == nil || len(got) != 0 {
+		t.Errorf("FilterMap(nil) = %#v, want an empty map", got)
+	}
+}
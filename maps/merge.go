@@ -0,0 +1,48 @@
+package maps
+
+import "fmt"
+
+// MergeMaps merges maps from left to right into a new map. When a key is
+// present in more than one map, resolve is called with the value merged so
+// far and the new value. Nil maps are treated as empty.
+func MergeMaps[K comparable, V any](resolve func(key K, a, b V) V, maps ...map[K]V) map[K]V {
+	size := 0
+	for _, m := range maps {
+		size = max(size, len(m))
+	}
+	result := make(map[K]V, size)
+	for _, m := range maps {
+		for k, v := range m {
+			if prev, ok := result[k]; ok {
+				v = resolve(k, prev, v)
+			}
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// MergeKeepFirst merges maps and keeps the first value seen for every key.
+func MergeKeepFirst[K comparable, V any](maps ...map[K]V) map[K]V {
+	return MergeMaps(func(_ K, a, _ V) V { return a }, maps...)
+}
+
+// MergeKeepLast merges maps and keeps the last value seen for every key.
+func MergeKeepLast[K comparable, V any](maps ...map[K]V) map[K]V {
+	return MergeMaps(func(_ K, _, b V) V { return b }, maps...)
+}
+
+// MergeDisjoint merges maps and returns an error if any key is present in
+// more than one of them.
+func MergeDisjoint[K comparable, V any](maps ...map[K]V) (map[K]V, error) {
+	result := make(map[K]V)
+	for i, m := range maps {
+		for k, v := range m {
+			if _, ok := result[k]; ok {
+				return nil, fmt.Errorf("maps: key %v of map %d is already present", k, i)
+			}
+			result[k] = v
+		}
+	}
+	return result, nil
+}
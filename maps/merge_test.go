@@ -0,0 +1,93 @@
+package maps
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeMaps(t *testing.T) {
+	a := map[string]int{"x": 1, "y": 2}
+	b := map[string]int{"y": 10, "z": 3}
+	c := map[string]int{"y": 100}
+	var calls []string
+	got := MergeMaps(func(key string, acc, v int) int {
+		calls = append(calls, key)
+		return acc + v
+	}, a, b, c)
+	if want := map[string]int{"x": 1, "y": 112, "z": 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MergeMaps = %v, want %v", got, want)
+	}
+	if want := []string{"y", "y"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("resolve called for %v, want %v", calls, want)
+	}
+	if want := map[string]int{"x": 1, "y": 2}; !reflect.DeepEqual(a, want) {
+		t.Errorf("MergeMaps modified its first input to %v", a)
+	}
+}
+
+func TestMergeMapsLeftToRight(t *testing.T) {
+	// resolve must see the accumulated value first, so subtraction tells
+	// the operands apart.
+	got := MergeMaps(func(_ string, acc, v int) int { return acc - v },
+		map[string]int{"k": 10}, map[string]int{"k": 3}, map[string]int{"k": 2})
+	if got["k"] != 5 {
+		t.Errorf("MergeMaps = %v, want k=5", got)
+	}
+}
+
+func TestMergeNil(t *testing.T) {
+	a := map[string]int{"x": 1}
+	for _, got := range []map[string]int{
+		MergeKeepFirst(nil, a, nil),
+		MergeKeepLast[string, int](a, nil),
+	} {
+		if !reflect.DeepEqual(got, a) {
+			t.Errorf("merge with nil maps = %v, want %v", got, a)
+		}
+	}
+	if got := MergeKeepLast[string, int](); got == nil || len(got) != 0 {
+		t.Errorf("MergeKeepLast() = %#v, want an empty map", got)
+	}
+	got, err := MergeDisjoint(nil, a)
+	if err != nil || !reflect.DeepEqual(got, a) {
+		t.Errorf("MergeDisjoint(nil, a) = %v, %v, want %v, nil", got, err, a)
+	}
+}
+
+func TestMergeKeep(t *testing.T) {
+	a := map[string]int{"x": 1, "y": 2}
+	b := map[string]int{"y": 20, "z": 30}
+	if got, want := MergeKeepFirst(a, b), map[string]int{"x": 1, "y": 2, "z": 30}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MergeKeepFirst = %v, want %v", got, want)
+	}
+	if got, want := MergeKeepLast(a, b), map[string]int{"x": 1, "y": 20, "z": 30}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MergeKeepLast = %v, want %v", got, want)
+	}
+}
+
+func TestMergeSameMapTwice(t *testing.T) {
+	a := map[string]int{"x": 1, "y": 2}
+	got := MergeMaps(func(_ string, acc, v int) int { return acc + v }, a, a)
+	if want := map[string]int{"x": 2, "y": 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MergeMaps(a, a) = %v, want %v", got, want)
+	}
+	if got := MergeKeepFirst(a, a); !reflect.DeepEqual(got, a) {
+		t.Errorf("MergeKeepFirst(a, a) = %v, want %v", got, a)
+	}
+	if _, err := MergeDisjoint(a, a); err == nil {
+		t.Error("MergeDisjoint(a, a) succeeded")
+	}
+}
+
+func TestMergeDisjoint(t *testing.T) {
+	got, err := MergeDisjoint(map[int]string{1: "a"}, map[int]string{2: "b"})
+	if want := map[int]string{1: "a", 2: "b"}; err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("MergeDisjoint = %v, %v, want %v, nil", got, err, want)
+	}
+	got, err = MergeDisjoint(map[int]string{1: "a"}, map[int]string{1: "b"})
+	if got != nil || err == nil {
+		t.Errorf("MergeDisjoint with a collision = %v, %v, want nil and an error", got, err)
+	} else if want := "maps: key 1 of map 1 is already present"; err.Error() != want {
+		t.Errorf("error = %q, want %q", err, want)
+	}
+}
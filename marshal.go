@@ -0,0 +1,95 @@
+package main
+
+import (
+	"fmt"
+	"go/token"
+	"go/types"
+	"os"
+	"strings"
+
+	"projektarbeit-go-generics/codec"
+	"projektarbeit-go-generics/ref"
+)
+
+// jsonLines writes one JSON object per line. Map keys are sorted, which
+// keeps the output stable.
+var jsonLines codec.Encoder[map[string]any] = codec.JSONEncoder[map[string]any]{}
+
+func kindName(v any) string {
+	return strings.TrimPrefix(fmt.Sprintf("%T", v), "*types.")
+}
+
+func marshalObj(fset *token.FileSet, obj types.Object) map[string]any {
+	m := map[string]any{
+		"name":           obj.Name(),
+		"kind":           kindName(obj),
+		"type":           displayType(obj.Type()),
+		"pkg":            nil,
+		"pos":            fset.Position(obj.Pos()).String(),
+		"exported":       obj.Exported(),
+		"underlyingKind": kindName(obj.Type().Underlying()),
+	}
+	if obj.Pkg() != nil {
+		m["pkg"] = obj.Pkg().Path()
+	}
+	if f, ok := obj.(*types.Func); ok {
+		sig := f.Type().(*types.Signature)
+		m["params"] = marshalTuple(sig.Params())
+		m["results"] = marshalTuple(sig.Results())
+	}
+	if tn, ok := obj.(*types.TypeName); ok {
+		if named, ok := tn.Type().(*types.Named); ok && named.TypeParams() != nil {
+			m["typeParams"] = marshalTypeParams(named.TypeParams())
+		}
+	}
+	return m
+}
+
+func marshalTuple(tuple *types.Tuple) []map[string]any {
+	vars := make([]map[string]any, 0, tuple.Len())
+	for i := range tuple.Len() {
+		v := tuple.At(i)
+		vars = append(vars, map[string]any{"name": v.Name(), "type": v.Type().String()})
+	}
+	return vars
+}
+
+func marshalTypeParams(tparams *types.TypeParamList) []map[string]any {
+	params := make([]map[string]any, 0, tparams.Len())
+	for i := range tparams.Len() {
+		tp := tparams.At(i)
+		terms := constraintTerms(tp.Constraint().Underlying().(*types.Interface))
+		termStrings := make([]string, len(terms))
+		for j, term := range terms {
+			termStrings[j] = term.String()
+		}
+		param := map[string]any{
+			"name":           tp.Obj().Name(),
+			"constraint":     tp.Constraint().String(),
+			"terms":          termStrings,
+			"structuralType": nil,
+		}
+		if st := structuralType(terms); st != nil {
+			param["structuralType"] = st.String()
+		}
+		params = append(params, param)
+	}
+	return params
+}
+
+// printJSON writes one lookup as a single JSON line. commentPos is the
+// position of the inspect comment and pos that of the declaration, which
+// is null for unresolved names.
+func printJSON(fset *token.FileSet, pos token.Pos, name string, obj ref.Ref[types.Object], sel *selection) error {
+	m := map[string]any{"name": name, "pos": nil, "found": false}
+	if obj.IsPresent() {
+		m = marshalObj(fset, obj.Value())
+		m["name"] = name
+		m["found"] = true
+	}
+	m["commentPos"] = fset.Position(pos).String()
+	if sel != nil {
+		m["selection"] = marshalSelection(sel)
+	}
+	return jsonLines.Encode(os.Stdout, m)
+}
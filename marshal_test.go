@@ -0,0 +1,41 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// checkGolden compares got with the golden file name and rewrites the file
+// instead when the test runs with -update.
+func checkGolden(t *testing.T, name, got string) {
+	t.Helper()
+	if *update {
+		if err := os.WriteFile(name, []byte(got), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != string(want) {
+		t.Errorf("output differs from %s, rerun with -update to accept it:\ngot:\n%s\nwant:\n%s", name, got, want)
+	}
+}
+
+func TestJSONOutputGolden(t *testing.T) {
+	defer func(old string) { *output = old }(*output)
+	*output = "json"
+
+	got := captureStdout(t, func() error { return inspectFiles([]string{"testdata/objects.go"}) })
+	checkGolden(t, "testdata/objects.json.golden", got)
+}
+
+func TestTextOutputGolden(t *testing.T) {
+	got := captureStdout(t, func() error { return inspectFiles([]string{"testdata/objects.go"}) })
+	checkGolden(t, "testdata/objects.txt.golden", got)
+}
@@ -0,0 +1,115 @@
+// Package memo provides generic memoization of pure functions.
+package memo
+
+import "sync"
+
+// Memo is the cache behind a memoized function. It can be used to inspect
+// or invalidate cached results.
+type Memo[K comparable, V any] struct {
+	mu     *sync.RWMutex
+	values map[K]V
+}
+
+func newMemo[K comparable, V any](safe bool) *Memo[K, V] {
+	m := &Memo[K, V]{values: make(map[K]V)}
+	if safe {
+		m.mu = &sync.RWMutex{}
+	}
+	return m
+}
+
+func (m *Memo[K, V]) load(key K) (V, bool) {
+	if m.mu != nil {
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+	}
+	v, ok := m.values[key]
+	return v, ok
+}
+
+func (m *Memo[K, V]) store(key K, v V) {
+	if m.mu != nil {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+	}
+	m.values[key] = v
+}
+
+// Evict removes the cached result for key.
+func (m *Memo[K, V]) Evict(key K) {
+	if m.mu != nil {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+	}
+	delete(m.values, key)
+}
+
+// EvictAll removes all cached results.
+func (m *Memo[K, V]) EvictAll() {
+	if m.mu != nil {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+	}
+	clear(m.values)
+}
+
+// Len returns the number of cached results.
+func (m *Memo[K, V]) Len() int {
+	if m.mu != nil {
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+	}
+	return len(m.values)
+}
+
+func memoize[K comparable, V any](f func(K) V, safe bool) (func(K) V, *Memo[K, V]) {
+	m := newMemo[K, V](safe)
+	return func(key K) V {
+		if v, ok := m.load(key); ok {
+			return v
+		}
+		v := f(key)
+		m.store(key, v)
+		return v
+	}, m
+}
+
+func memoizeErr[K comparable, V any](f func(K) (V, error), safe bool) (func(K) (V, error), *Memo[K, V]) {
+	m := newMemo[K, V](safe)
+	return func(key K) (V, error) {
+		if v, ok := m.load(key); ok {
+			return v, nil
+		}
+		v, err := f(key)
+		if err != nil {
+			return v, err
+		}
+		m.store(key, v)
+		return v, nil
+	}, m
+}
+
+// Memoize returns a function that calls f once per key and caches the
+// result. The returned function is not safe for concurrent use.
+func Memoize[K comparable, V any](f func(K) V) (func(K) V, *Memo[K, V]) {
+	return memoize(f, false)
+}
+
+// MemoizeErr is like Memoize but only caches results for which f returned
+// no error.
+func MemoizeErr[K comparable, V any](f func(K) (V, error)) (func(K) (V, error), *Memo[K, V]) {
+	return memoizeErr(f, false)
+}
+
+// MemoizeSafe is like Memoize but the returned function may be called from
+// multiple goroutines. Concurrent calls for a missing key may call f more
+// than once.
+func MemoizeSafe[K comparable, V any](f func(K) V) (func(K) V, *Memo[K, V]) {
+	return memoize(f, true)
+}
+
+// MemoizeErrSafe is like MemoizeErr but the returned function may be called
+// from multiple goroutines.
+func MemoizeErrSafe[K comparable, V any](f func(K) (V, error)) (func(K) (V, error), *Memo[K, V]) {
+	return memoizeErr(f, true)
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"fmt"
+	"go/token"
+	"go/types"
+	"strings"
+)
+
+// MethodSetDiff compares the method sets of a and b. A method is changed
+// if both have it with different signatures; changed holds the methods of
+// a. Pass pointer types to compare the method sets of *T. All results are
+// sorted by name.
+func MethodSetDiff(a, b types.Type) (onlyInA, onlyInB, changed []*types.Func) {
+	setA, setB := types.NewMethodSet(a), types.NewMethodSet(b)
+	for i := range setA.Len() {
+		ma := setA.At(i).Obj().(*types.Func)
+		selB := setB.Lookup(ma.Pkg(), ma.Name())
+		switch {
+		case selB == nil:
+			onlyInA = append(onlyInA, ma)
+		case !types.Identical(ma.Type(), selB.Obj().Type()):
+			changed = append(changed, ma)
+		}
+	}
+	for i := range setB.Len() {
+		mb := setB.At(i).Obj().(*types.Func)
+		if setA.Lookup(mb.Pkg(), mb.Name()) == nil {
+			onlyInB = append(onlyInB, mb)
+		}
+	}
+	return onlyInA, onlyInB, changed
+}
+
+// methodDiff evaluates "TypeA TypeB" and lists the methods that differ
+// between their method sets.
+func methodDiff(c checkedPackage, pos token.Pos, scope *types.Scope, args string) string {
+	names := strings.Fields(args)
+	if len(names) != 2 {
+		return "\t<invalid directive, expected: TypeA TypeB>\n"
+	}
+	var ts [2]types.Type
+	for i, name := range names {
+		t, ok := lookupType(c.pkg, scope, pos, name)
+		if !ok {
+			return fmt.Sprintf("\t<type %s not found>\n", name)
+		}
+		ts[i] = t
+	}
+
+	onlyInA, onlyInB, changed := MethodSetDiff(ts[0], ts[1])
+	if len(onlyInA)+len(onlyInB)+len(changed) == 0 {
+		return "\t<no differences>\n"
+	}
+	buff := &strings.Builder{}
+	for _, m := range onlyInA {
+		fmt.Fprintf(buff, "\t- %s%s\n", m.Name(), methodSignature(m))
+	}
+	for _, m := range onlyInB {
+		fmt.Fprintf(buff, "\t+ %s%s\n", m.Name(), methodSignature(m))
+	}
+	setB := types.NewMethodSet(ts[1])
+	for _, m := range changed {
+		other := setB.Lookup(m.Pkg(), m.Name()).Obj().(*types.Func)
+		fmt.Fprintf(buff, "\t~ %s%s -> %s%s\n", m.Name(), methodSignature(m), m.Name(), methodSignature(other))
+	}
+	return buff.String()
+}
+
+func methodSignature(m *types.Func) string {
+	return strings.TrimPrefix(m.Type().String(), "func")
+}
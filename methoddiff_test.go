@@ -0,0 +1,87 @@
+package main
+
+import (
+	"go/types"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const methodDiffBefore = `package main
+
+type MyStruct struct{ n int }
+
+func (MyStruct) String() string { return "" }
+func (s *MyStruct) Set(n int)    { s.n = n }
+func (MyStruct) Size() int       { return 0 }
+`
+
+const methodDiffAfter = `package main
+
+type MyStruct struct{ n int }
+
+func (MyStruct) String() string  { return "" }
+func (s *MyStruct) Set(n int)     { s.n = n }
+func (MyStruct) Size() int64      { return 0 }
+func (MyStruct) Reset()           {}
+`
+
+func methodNames(t *testing.T, methods []*types.Func) []string {
+	t.Helper()
+	var names []string
+	for _, m := range methods {
+		names = append(names, m.Name())
+	}
+	return names
+}
+
+func TestMethodSetDiff(t *testing.T) {
+	before := checkSource(t, methodDiffBefore).pkg.Scope().Lookup("MyStruct").Type()
+	after := checkSource(t, methodDiffAfter).pkg.Scope().Lookup("MyStruct").Type()
+
+	onlyInA, onlyInB, changed := MethodSetDiff(before, after)
+	if len(onlyInA) != 0 {
+		t.Errorf("onlyInA = %v, want none", methodNames(t, onlyInA))
+	}
+	if got, want := methodNames(t, onlyInB), []string{"Reset"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("onlyInB = %v, want %v", got, want)
+	}
+	if got, want := methodNames(t, changed), []string{"Size"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("changed = %v, want %v", got, want)
+	}
+
+	// The method set of *MyStruct also holds Set, which both have alike.
+	onlyInA, onlyInB, _ = MethodSetDiff(types.NewPointer(after), after)
+	if got, want := methodNames(t, onlyInA), []string{"Set"}; !reflect.DeepEqual(got, want) || len(onlyInB) != 0 {
+		t.Errorf("MethodSetDiff(*MyStruct, MyStruct) = %v, %v, want %v, none", got, methodNames(t, onlyInB), want)
+	}
+}
+
+func TestMethodDiffDirective(t *testing.T) {
+	src := methodDiffAfter + `
+type Old struct{}
+
+func (Old) Size() int     { return 0 }
+func (Old) Close() error { return nil }
+
+// methoddiff: Old MyStruct
+// methoddiff: *MyStruct *MyStruct
+// methoddiff: Old
+// methoddiff: Old Missing
+func main() {}
+`
+	out := inspect(t, src)
+	for _, want := range []string{
+		"\t- Close() error\n",
+		"\t+ Reset()\n",
+		"\t+ String() string\n",
+		"\t~ Size() int -> Size() int64\n",
+		"\t<no differences>\n",
+		"\t<invalid directive, expected: TypeA TypeB>\n",
+		"\t<type Missing not found>\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output does not contain %q:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"go/token"
+	"go/types"
+	"sort"
+	"strings"
+)
+
+// methodSet prints the method sets of a named type T and of *T. Methods
+// that are hidden because several embedded fields promote them at the same
+// depth are listed separately.
+func methodSet(c checkedPackage, pos token.Pos, scope *types.Scope, args string) string {
+	t, ok := lookupType(c.pkg, scope, pos, args)
+	if !ok {
+		return fmt.Sprintf("\t<type %s not found>\n", args)
+	}
+
+	buff := &strings.Builder{}
+	valueSet := types.NewMethodSet(t)
+	pointerSet := types.NewMethodSet(types.NewPointer(t))
+
+	fmt.Fprintf(buff, "\tMethod Set %s:\n", t.String())
+	formatMethodSet(buff, c.fset, valueSet, nil)
+	fmt.Fprintf(buff, "\tMethod Set *%s:\n", t.String())
+	formatMethodSet(buff, c.fset, pointerSet, valueSet)
+
+	if ambiguous := ambiguousMethods(c.pkg, t); len(ambiguous) > 0 {
+		fmt.Fprintf(buff, "\tAmbiguous (excluded from both sets):\n")
+		for _, name := range ambiguous {
+			fmt.Fprintf(buff, "\t\t%s\n", name)
+		}
+	}
+	return buff.String()
+}
+
+func formatMethodSet(buff *strings.Builder, fset *token.FileSet, mset, valueSet *types.MethodSet) {
+	if mset.Len() == 0 {
+		fmt.Fprintf(buff, "\t\t<empty>\n")
+	}
+	for i := range mset.Len() {
+		sel := mset.At(i)
+		fn := sel.Obj().(*types.Func)
+		origin := "declared"
+		switch {
+		case valueSet != nil && valueSet.Lookup(fn.Pkg(), fn.Name()) == nil:
+			origin = "via pointer"
+		case len(sel.Index()) > 1:
+			origin = fmt.Sprintf("promoted via embedding, index %v", sel.Index())
+		}
+		sig := strings.TrimPrefix(fn.Type().(*types.Signature).String(), "func")
+		fmt.Fprintf(buff, "\t\t%s%s (%s) %s\n", fn.Name(), sig, origin, fset.Position(fn.Pos()))
+	}
+}
+
+// ambiguousMethods returns the names of methods of embedded fields that are
+// not promoted to t because the selector would be ambiguous.
+func ambiguousMethods(pkg *types.Package, t types.Type) []string {
+	st, ok := t.Underlying().(*types.Struct)
+	if !ok {
+		return nil
+	}
+	candidates := make(map[string]bool)
+	for i := range st.NumFields() {
+		field := st.Field(i)
+		if !field.Embedded() {
+			continue
+		}
+		ft := field.Type()
+		if _, isPtr := ft.Underlying().(*types.Pointer); !isPtr && !types.IsInterface(ft) {
+			ft = types.NewPointer(ft) // Include pointer methods, embedding *T does not need an extra level
+		}
+		mset := types.NewMethodSet(ft)
+		for j := range mset.Len() {
+			candidates[mset.At(j).Obj().Name()] = true
+		}
+	}
+
+	var names []string
+	for name := range candidates {
+		obj, index, _ := types.LookupFieldOrMethod(t, true, pkg, name)
+		if obj == nil && index != nil {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
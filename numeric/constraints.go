@@ -0,0 +1,28 @@
+// Package numeric provides numeric type constraints and generic functions
+// built on them.
+package numeric
+
+// SignedInteger is the set of signed integer types.
+type SignedInteger interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// UnsignedInteger is the set of unsigned integer types.
+type UnsignedInteger interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Integer is the set of all integer types.
+type Integer interface {
+	SignedInteger | UnsignedInteger
+}
+
+// Float is the set of floating-point types.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Numeric is the set of all integer and floating-point types.
+type Numeric interface {
+	Integer | Float
+}
@@ -0,0 +1,68 @@
+package numeric
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"testing"
+)
+
+// customInt is an integer type with a custom name, like the element type
+// of the playground's customInt examples.
+type customInt int
+
+// These instantiations fail to compile if customInt stops satisfying the
+// constraints.
+var (
+	_ = Sign[customInt]
+	_ = AbsInt[customInt]
+	_ = SaturatingAdd[customInt]
+)
+
+// checkConstraints type-checks constraints.go together with an extra
+// declaration of customInt, so that tests can ask which constraints a type
+// does not satisfy, which the compiler cannot express.
+func checkConstraints(t *testing.T) *types.Package {
+	t.Helper()
+	fset := token.NewFileSet()
+	constraints, err := parser.ParseFile(fset, "constraints.go", nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	extra, err := parser.ParseFile(fset, "extra.go", "package numeric\n\ntype customInt int\ntype customFloat float32\n", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkg, err := (&types.Config{}).Check("numeric", fset, []*ast.File{constraints, extra}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pkg
+}
+
+func TestConstraintSatisfaction(t *testing.T) {
+	pkg := checkConstraints(t)
+	iface := func(name string) *types.Interface {
+		return pkg.Scope().Lookup(name).Type().Underlying().(*types.Interface)
+	}
+	named := func(name string) types.Type { return pkg.Scope().Lookup(name).Type() }
+	for _, tt := range []struct {
+		typ        string
+		constraint string
+		want       bool
+	}{
+		{"customInt", "Numeric", true},
+		{"customInt", "Integer", true},
+		{"customInt", "SignedInteger", true},
+		{"customInt", "UnsignedInteger", false},
+		{"customInt", "Float", false},
+		{"customFloat", "Numeric", true},
+		{"customFloat", "Float", true},
+		{"customFloat", "Integer", false},
+	} {
+		if got := types.Satisfies(named(tt.typ), iface(tt.constraint)); got != tt.want {
+			t.Errorf("%s satisfies %s = %v, want %v", tt.typ, tt.constraint, got, tt.want)
+		}
+	}
+}
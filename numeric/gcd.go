@@ -0,0 +1,83 @@
+package numeric
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// magnitude returns |v| as uint64, which also works for the smallest value
+// of a signed type.
+func magnitude[T Integer](v T) uint64 {
+	if v < 0 {
+		return uint64(-(v + 1)) + 1
+	}
+	return uint64(v)
+}
+
+func fromMagnitude[T Integer](m uint64, op string) T {
+	if _, hi := bounds[T](); m > uint64(hi) {
+		panic(fmt.Sprintf("numeric: %s overflows", op))
+	}
+	return T(m)
+}
+
+// stein computes the greatest common divisor with the binary GCD algorithm.
+func stein(a, b uint64) uint64 {
+	if a == 0 {
+		return b
+	}
+	if b == 0 {
+		return a
+	}
+	shift := bits.TrailingZeros64(a | b)
+	a >>= bits.TrailingZeros64(a)
+	for b != 0 {
+		b >>= bits.TrailingZeros64(b)
+		if a > b {
+			a, b = b, a
+		}
+		b -= a
+	}
+	return a << shift
+}
+
+// GCD returns the greatest common divisor of a and b, which is always
+// non-negative. GCD(0, 0) is 0. It panics if the result does not fit into
+// T, which only happens for the smallest value of a signed type.
+func GCD[T Integer](a, b T) T {
+	return fromMagnitude[T](stein(magnitude(a), magnitude(b)), "GCD")
+}
+
+// LCM returns the least common multiple |a*b| / GCD(a, b) of a and b, or 0
+// if either is 0. It panics if the result does not fit into T.
+func LCM[T Integer](a, b T) T {
+	ma, mb := magnitude(a), magnitude(b)
+	if ma == 0 || mb == 0 {
+		return 0
+	}
+	hi, lo := bits.Mul64(ma/stein(ma, mb), mb)
+	if hi != 0 {
+		panic("numeric: LCM overflows")
+	}
+	return fromMagnitude[T](lo, "LCM")
+}
+
+// GCDMany returns the greatest common divisor of all values, or 0 if there
+// are none.
+func GCDMany[T Integer](values ...T) T {
+	var g T
+	for _, v := range values {
+		g = GCD(g, v)
+	}
+	return g
+}
+
+// LCMMany returns the least common multiple of all values, or 1 if there
+// are none.
+func LCMMany[T Integer](values ...T) T {
+	l := T(1)
+	for _, v := range values {
+		l = LCM(l, v)
+	}
+	return l
+}
@@ -0,0 +1,40 @@
+package numeric
+
+import (
+	"cmp"
+	"math"
+)
+
+// Abs returns the absolute value of v. For signed integers it panics if v
+// is the smallest value of T, whose absolute value is not representable.
+func Abs[T SignedInteger | Float](v T) T {
+	if v == 0 {
+		return 0 // Turns -0.0 into +0.0
+	}
+	if v > 0 || v != v {
+		return v // Positive or NaN
+	}
+	if -v < 0 {
+		panic("numeric: absolute value of the minimum integer overflows")
+	}
+	return -v
+}
+
+// Clamp returns lo if v < lo, hi if v > hi and v otherwise. It panics if
+// lo > hi.
+func Clamp[T cmp.Ordered](v, lo, hi T) T {
+	if hi < lo {
+		panic("numeric: Clamp called with lo > hi")
+	}
+	return min(max(v, lo), hi)
+}
+
+// Lerp interpolates linearly between a and b. t is not restricted to [0, 1],
+// values outside extrapolate. The multiply-add is fused, so only b-a and
+// the final result are rounded. The result is exactly b for t == 1.
+func Lerp[T Float](a, b, t T) T {
+	if t == 1 {
+		return b
+	}
+	return T(math.FMA(float64(t), float64(b)-float64(a), float64(a)))
+}
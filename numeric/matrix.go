@@ -0,0 +1,95 @@
+package numeric
+
+import "fmt"
+
+// Matrix is a dense row-major matrix backed by a single flat slice.
+type Matrix[T Numeric] struct {
+	rows, cols int
+	data       []T
+}
+
+// NewMatrix returns a rows×cols matrix filled with zeros.
+func NewMatrix[T Numeric](rows, cols int) *Matrix[T] {
+	if rows < 0 || cols < 0 {
+		panic(fmt.Sprintf("numeric: invalid matrix dimensions %dx%d", rows, cols))
+	}
+	return &Matrix[T]{rows: rows, cols: cols, data: make([]T, rows*cols)}
+}
+
+// MatrixFromSlice returns a rows×cols matrix holding data in row-major
+// order. The matrix uses data directly without copying it.
+func MatrixFromSlice[T Numeric](rows, cols int, data []T) *Matrix[T] {
+	if rows < 0 || cols < 0 || len(data) != rows*cols {
+		panic(fmt.Sprintf("numeric: %d values do not fit a %dx%d matrix", len(data), rows, cols))
+	}
+	return &Matrix[T]{rows: rows, cols: cols, data: data}
+}
+
+// Rows returns the number of rows.
+func (m *Matrix[T]) Rows() int {
+	return m.rows
+}
+
+// Cols returns the number of columns.
+func (m *Matrix[T]) Cols() int {
+	return m.cols
+}
+
+func (m *Matrix[T]) index(r, c int) int {
+	if r < 0 || r >= m.rows || c < 0 || c >= m.cols {
+		panic(fmt.Sprintf("numeric: index (%d, %d) out of range for %dx%d matrix", r, c, m.rows, m.cols))
+	}
+	return r*m.cols + c
+}
+
+// At returns the element in row r and column c.
+func (m *Matrix[T]) At(r, c int) T {
+	return m.data[m.index(r, c)]
+}
+
+// Set sets the element in row r and column c to v.
+func (m *Matrix[T]) Set(r, c int, v T) {
+	m.data[m.index(r, c)] = v
+}
+
+// Transpose returns a new matrix with rows and columns exchanged.
+func (m *Matrix[T]) Transpose() *Matrix[T] {
+	t := NewMatrix[T](m.cols, m.rows)
+	for r := range m.rows {
+		for c := range m.cols {
+			t.data[c*m.rows+r] = m.data[r*m.cols+c]
+		}
+	}
+	return t
+}
+
+// Add returns the element-wise sum of m and other.
+func (m *Matrix[T]) Add(other *Matrix[T]) (*Matrix[T], error) {
+	if m.rows != other.rows || m.cols != other.cols {
+		return nil, fmt.Errorf("numeric: cannot add %dx%d and %dx%d matrices", m.rows, m.cols, other.rows, other.cols)
+	}
+	sum := NewMatrix[T](m.rows, m.cols)
+	for i := range m.data {
+		sum.data[i] = m.data[i] + other.data[i]
+	}
+	return sum, nil
+}
+
+// Mul returns the matrix product of m and other.
+func (m *Matrix[T]) Mul(other *Matrix[T]) (*Matrix[T], error) {
+	if m.cols != other.rows {
+		return nil, fmt.Errorf("numeric: cannot multiply %dx%d and %dx%d matrices", m.rows, m.cols, other.rows, other.cols)
+	}
+	product := NewMatrix[T](m.rows, other.cols)
+	for r := range m.rows {
+		row := product.data[r*other.cols : (r+1)*other.cols]
+		for k := range m.cols {
+			a := m.data[r*m.cols+k]
+			otherRow := other.data[k*other.cols : (k+1)*other.cols]
+			for c, b := range otherRow { // The i-k-j order walks both operands sequentially
+				row[c] += a * b
+			}
+		}
+	}
+	return product, nil
+}
@@ -0,0 +1,114 @@
+package numeric
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMatrixAtSet(t *testing.T) {
+	m := NewMatrix[int](2, 3)
+	if m.Rows() != 2 || m.Cols() != 3 {
+		t.Errorf("dimensions = %dx%d, want 2x3", m.Rows(), m.Cols())
+	}
+	m.Set(1, 2, 7)
+	if got := m.At(1, 2); got != 7 {
+		t.Errorf("At(1, 2) = %d, want 7", got)
+	}
+	if got := m.data; !slices.Equal(got, []int{0, 0, 0, 0, 0, 7}) {
+		t.Errorf("data = %v, want row-major layout", got)
+	}
+}
+
+func TestMatrixPanics(t *testing.T) {
+	for name, f := range map[string]func(){
+		"out of range":       func() { NewMatrix[int](2, 2).At(2, 0) },
+		"negative dimension": func() { NewMatrix[int](-1, 2) },
+		"wrong data length":  func() { MatrixFromSlice(2, 2, []int{1, 2, 3}) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: did not panic", name)
+				}
+			}()
+			f()
+		}()
+	}
+}
+
+func TestMatrixTranspose(t *testing.T) {
+	m := MatrixFromSlice(2, 3, []int{1, 2, 3, 4, 5, 6})
+	tr := m.Transpose()
+	if tr.Rows() != 3 || tr.Cols() != 2 || !slices.Equal(tr.data, []int{1, 4, 2, 5, 3, 6}) {
+		t.Errorf("Transpose = %dx%d %v, want 3x2 [1 4 2 5 3 6]", tr.Rows(), tr.Cols(), tr.data)
+	}
+}
+
+func TestMatrixAdd(t *testing.T) {
+	a := MatrixFromSlice(2, 2, []float64{1, 2, 3, 4})
+	b := MatrixFromSlice(2, 2, []float64{0.5, 0.5, 0.5, 0.5})
+	sum, err := a.Add(b)
+	if err != nil || !slices.Equal(sum.data, []float64{1.5, 2.5, 3.5, 4.5}) {
+		t.Errorf("Add = %v, %v, want [1.5 2.5 3.5 4.5], nil", sum, err)
+	}
+	if _, err := a.Add(NewMatrix[float64](2, 3)); err == nil {
+		t.Error("Add of different dimensions succeeded")
+	}
+}
+
+func TestMatrixMul(t *testing.T) {
+	a := MatrixFromSlice(2, 3, []int{1, 2, 3, 4, 5, 6})
+	b := MatrixFromSlice(3, 2, []int{7, 8, 9, 10, 11, 12})
+	p, err := a.Mul(b)
+	if err != nil || p.Rows() != 2 || p.Cols() != 2 || !slices.Equal(p.data, []int{58, 64, 139, 154}) {
+		t.Errorf("Mul = %v, %v, want 2x2 [58 64 139 154], nil", p, err)
+	}
+	if _, err := a.Mul(a); err == nil {
+		t.Error("Mul of incompatible dimensions succeeded")
+	}
+}
+
+const benchMatrixSize = 512
+
+func benchmarkMatrix() *Matrix[float64] {
+	m := NewMatrix[float64](benchMatrixSize, benchMatrixSize)
+	for i := range m.data {
+		m.data[i] = float64(i%7) + 0.5
+	}
+	return m
+}
+
+func BenchmarkMatrixMul(b *testing.B) {
+	m := benchmarkMatrix()
+	b.ResetTimer()
+	for range b.N {
+		m.Mul(m)
+	}
+}
+
+// mulNested multiplies two matrices stored as slices of rows, in the same
+// loop order as Matrix.Mul.
+func mulNested(a, b [][]float64) [][]float64 {
+	product := make([][]float64, len(a))
+	for r := range a {
+		product[r] = make([]float64, len(b[0]))
+		for k, x := range a[r] {
+			for c, y := range b[k] {
+				product[r][c] += x * y
+			}
+		}
+	}
+	return product
+}
+
+func BenchmarkNestedMul(b *testing.B) {
+	m := benchmarkMatrix()
+	nested := make([][]float64, benchMatrixSize)
+	for r := range nested {
+		nested[r] = slices.Clone(m.data[r*benchMatrixSize : (r+1)*benchMatrixSize])
+	}
+	b.ResetTimer()
+	for range b.N {
+		mulNested(nested, nested)
+	}
+}
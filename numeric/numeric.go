@@ -0,0 +1,59 @@
+package numeric
+
+import (
+	"math"
+	"unsafe"
+)
+
+// bounds returns the smallest and largest value of the integer type T.
+func bounds[T Integer]() (lo, hi T) {
+	var zero T
+	if ^zero > 0 {
+		return 0, ^zero
+	}
+	lo = T(1) << (unsafe.Sizeof(zero)*8 - 1)
+	return lo, ^lo
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of v. NaN yields 0.
+func Sign[T Numeric](v T) int {
+	switch {
+	case v > 0:
+		return +1
+	case v < 0:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// AbsInt returns the absolute value of v. It panics if v is the smallest
+// value of T, whose absolute value is not representable.
+func AbsInt[T SignedInteger](v T) T {
+	return Abs(v)
+}
+
+// SaturatingAdd returns a + b, clamped to the range of T instead of wrapping
+// around.
+func SaturatingAdd[T Integer](a, b T) T {
+	lo, hi := bounds[T]()
+	sum := a + b
+	switch {
+	case b > 0 && sum < a:
+		return hi
+	case b < 0 && sum > a:
+		return lo
+	}
+	return sum
+}
+
+// IsPowerOfTwo reports whether v is a power of two.
+func IsPowerOfTwo[T UnsignedInteger](v T) bool {
+	return v != 0 && v&(v-1) == 0
+}
+
+// IsWhole reports whether v is a finite number without a fractional part.
+func IsWhole[T Float](v T) bool {
+	f := float64(v)
+	return !math.IsInf(f, 0) && f == math.Trunc(f)
+}
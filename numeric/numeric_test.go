@@ -0,0 +1,69 @@
+package numeric
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSign(t *testing.T) {
+	for _, tt := range []struct {
+		v    float64
+		want int
+	}{{-2.5, -1}, {0, 0}, {3, 1}, {math.NaN(), 0}} {
+		if got := Sign(tt.v); got != tt.want {
+			t.Errorf("Sign(%v) = %d, want %d", tt.v, got, tt.want)
+		}
+	}
+	if got := Sign(customInt(-4)); got != -1 {
+		t.Errorf("Sign(customInt(-4)) = %d, want -1", got)
+	}
+}
+
+func TestAbsInt(t *testing.T) {
+	if got := AbsInt(customInt(-7)); got != 7 {
+		t.Errorf("AbsInt(-7) = %d, want 7", got)
+	}
+	if got := AbsInt(int8(5)); got != 5 {
+		t.Errorf("AbsInt(5) = %d, want 5", got)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("AbsInt(math.MinInt8) did not panic")
+		}
+	}()
+	AbsInt(int8(math.MinInt8))
+}
+
+func TestSaturatingAdd(t *testing.T) {
+	if got := SaturatingAdd(int8(100), 100); got != math.MaxInt8 {
+		t.Errorf("SaturatingAdd(100, 100) = %d, want %d", got, math.MaxInt8)
+	}
+	if got := SaturatingAdd(int8(-100), -100); got != math.MinInt8 {
+		t.Errorf("SaturatingAdd(-100, -100) = %d, want %d", got, math.MinInt8)
+	}
+	if got := SaturatingAdd(uint8(200), 100); got != math.MaxUint8 {
+		t.Errorf("SaturatingAdd(200, 100) = %d, want %d", got, math.MaxUint8)
+	}
+	if got := SaturatingAdd(customInt(2), 3); got != 5 {
+		t.Errorf("SaturatingAdd(2, 3) = %d, want 5", got)
+	}
+}
+
+func TestIsPowerOfTwo(t *testing.T) {
+	for v, want := range map[uint]bool{0: false, 1: true, 2: true, 6: false, 1 << 40: true} {
+		if got := IsPowerOfTwo(v); got != want {
+			t.Errorf("IsPowerOfTwo(%d) = %v, want %v", v, got, want)
+		}
+	}
+}
+
+func TestIsWhole(t *testing.T) {
+	for v, want := range map[float64]bool{2: true, -3: true, 2.5: false, math.Inf(1): false} {
+		if got := IsWhole(v); got != want {
+			t.Errorf("IsWhole(%v) = %v, want %v", v, got, want)
+		}
+	}
+	if IsWhole(math.NaN()) {
+		t.Error("IsWhole(NaN) = true, want false")
+	}
+}
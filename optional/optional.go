@@ -0,0 +1,57 @@
+// Package optional provides a generic type for values that may be absent.
+package optional
+
+// Optional either holds a value of type T or is empty. The zero value is
+// empty.
+type Optional[T any] struct {
+	value   T
+	present bool
+}
+
+// Some returns an Optional holding v.
+func Some[T any](v T) Optional[T] {
+	return Optional[T]{value: v, present: true}
+}
+
+// None returns an empty Optional.
+func None[T any]() Optional[T] {
+	return Optional[T]{}
+}
+
+// IsPresent reports whether o holds a value.
+func (o Optional[T]) IsPresent() bool {
+	return o.present
+}
+
+// Get returns the held value and true, or the zero value and false if o is
+// empty.
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.present
+}
+
+// GetOrDefault returns the held value, or def if o is empty.
+func (o Optional[T]) GetOrDefault(def T) T {
+	if !o.present {
+		return def
+	}
+	return o.value
+}
+
+// Map applies f to the value held by o. An empty Optional stays empty.
+// Map is a function rather than a method because methods cannot declare
+// their own type parameters.
+func Map[T, U any](o Optional[T], f func(T) U) Optional[U] {
+	if !o.present {
+		return None[U]()
+	}
+	return Some(f(o.value))
+}
+
+// FlatMap applies f to the value held by o and returns its result. An empty
+// Optional stays empty.
+func FlatMap[T, U any](o Optional[T], f func(T) Optional[U]) Optional[U] {
+	if !o.present {
+		return None[U]()
+	}
+	return f(o.value)
+}
@@ -0,0 +1,81 @@
+package optional
+
+import (
+	"go/types"
+	"testing"
+)
+
+// lookup wraps a scope lookup the way inspect.go resolves names, turning
+// the nil object of an unknown name into None.
+func lookup(scope *types.Scope, name string) Optional[types.Object] {
+	if obj := scope.Lookup(name); obj != nil {
+		return Some(obj)
+	}
+	return None[types.Object]()
+}
+
+func TestOptionalObject(t *testing.T) {
+	found := lookup(types.Universe, "int")
+	if !found.IsPresent() {
+		t.Fatal("lookup of int is empty")
+	}
+	if obj, ok := found.Get(); !ok || obj.Name() != "int" {
+		t.Errorf("Get() = %v, %v, want int, true", obj, ok)
+	}
+
+	missing := lookup(types.Universe, "notDeclared")
+	if missing.IsPresent() {
+		t.Error("lookup of an undeclared name is present")
+	}
+	if obj, ok := missing.Get(); obj != nil || ok {
+		t.Errorf("Get() = %v, %v, want nil, false", obj, ok)
+	}
+	def := types.Universe.Lookup("any")
+	if got := missing.GetOrDefault(def); got != def {
+		t.Errorf("GetOrDefault = %v, want %v", got, def)
+	}
+	if got := found.GetOrDefault(def); got.Name() != "int" {
+		t.Errorf("GetOrDefault = %v, want int", got)
+	}
+}
+
+func TestZeroIsNone(t *testing.T) {
+	var o Optional[types.Object]
+	if o.IsPresent() || o != None[types.Object]() {
+		t.Error("zero Optional is not None")
+	}
+}
+
+func TestMap(t *testing.T) {
+	name := func(obj types.Object) string { return obj.Name() }
+	if got, ok := Map(lookup(types.Universe, "string"), name).Get(); !ok || got != "string" {
+		t.Errorf("Map(Some) = %q, %v, want \"string\", true", got, ok)
+	}
+	called := false
+	if Map(None[types.Object](), func(types.Object) string { called = true; return "" }).IsPresent() {
+		t.Error("Map(None) is present")
+	}
+	if called {
+		t.Error("Map(None) called f")
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	// Follow a type name to its underlying basic type, which only exists
+	// for basic types.
+	basic := func(obj types.Object) Optional[*types.Basic] {
+		if b, ok := obj.Type().Underlying().(*types.Basic); ok {
+			return Some(b)
+		}
+		return None[*types.Basic]()
+	}
+	if b, ok := FlatMap(lookup(types.Universe, "int"), basic).Get(); !ok || b.Kind() != types.Int {
+		t.Errorf("FlatMap(int) = %v, %v, want int, true", b, ok)
+	}
+	if FlatMap(lookup(types.Universe, "error"), basic).IsPresent() {
+		t.Error("FlatMap(error) is present, want None")
+	}
+	if FlatMap(lookup(types.Universe, "notDeclared"), basic).IsPresent() {
+		t.Error("FlatMap(None) is present")
+	}
+}
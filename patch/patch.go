@@ -0,0 +1,89 @@
+// Package patch applies partial updates to struct values.
+package patch
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Patch returns base with the fields named in mask copied over from
+// updates. Names may refer to fields promoted from embedded structs and
+// may be dotted paths into nested structs, e.g. "Address.Zip". Without a
+// mask, all fields are copied and Patch returns updates. Structs that are
+// reached through embedded pointers on the way to a field are copied
+// before the field is set, so base and the values it points to are never
+// modified.
+//
+// Patch panics if T is not a struct type, or if a name does not denote an
+// exported field reachable without following a nil pointer.
+func Patch[T any](base T, updates T, mask ...string) T {
+	dst := reflect.ValueOf(&base).Elem()
+	if dst.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("patch: %s is not a struct type", dst.Type()))
+	}
+	if len(mask) == 0 {
+		return updates
+	}
+	src := reflect.ValueOf(updates)
+	for _, path := range mask {
+		d, s := dst, src
+		for _, name := range strings.Split(path, ".") {
+			d, s = fieldForWrite(d, path, name), field(s, path, name)
+		}
+		d.Set(s)
+	}
+	return base
+}
+
+// field returns the field name of the struct v while resolving path.
+func field(v reflect.Value, path, name string) reflect.Value {
+	if v.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("patch: %q: %s is not a struct type", path, v.Type()))
+	}
+	sf, ok := v.Type().FieldByName(name)
+	if !ok || !sf.IsExported() {
+		panic(fmt.Sprintf("patch: %q: %s has no exported field %s", path, v.Type(), name))
+	}
+	f, err := v.FieldByIndexErr(sf.Index)
+	if err != nil {
+		panic(fmt.Sprintf("patch: %q: %v", path, err))
+	}
+	return f
+}
+
+// fieldForWrite is like field but replaces every pointer it follows in the
+// settable struct v by a pointer to a copy of its target.
+func fieldForWrite(v reflect.Value, path, name string) reflect.Value {
+	field(v, path, name) // Validates name and rejects nil pointers on the way
+	sf, _ := v.Type().FieldByName(name)
+	for i, index := range sf.Index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			clone := reflect.New(v.Type().Elem())
+			clone.Elem().Set(v.Elem())
+			v.Set(clone)
+			v = clone.Elem()
+		}
+		v = v.Field(index)
+	}
+	return v
+}
+
+// PatchFn returns a function that applies fields to a copy of its argument,
+// in order. Unlike Patch it needs no reflection.
+func PatchFn[T any](fields ...func(*T)) func(T) T {
+	return func(v T) T {
+		for _, f := range fields {
+			f(&v)
+		}
+		return v
+	}
+}
+
+// Set returns a PatchFn update that stores v in the field selected by get,
+// e.g. Set(func(s *S) *string { return &s.Name }, "new").
+func Set[T, F any](get func(*T) *F, v F) func(*T) {
+	return func(t *T) {
+		*get(t) = v
+	}
+}
@@ -0,0 +1,142 @@
+package patch
+
+import (
+	"reflect"
+	"testing"
+)
+
+type MyStruct struct {
+	Field1 string
+	Field2 int
+}
+
+type Address struct {
+	City, Zip string
+}
+
+type Meta struct {
+	Version int
+	Tags    []string
+}
+
+type Record struct {
+	*Meta
+	MyStruct
+	Address Address
+	private int
+}
+
+func TestPatchFields(t *testing.T) {
+	base := MyStruct{Field1: "hello", Field2: 10}
+	updates := MyStruct{Field1: "world", Field2: 20}
+	if got, want := Patch(base, updates, "Field1"), (MyStruct{"world", 10}); got != want {
+		t.Errorf("Patch(Field1) = %+v, want %+v", got, want)
+	}
+	if got := Patch(base, updates, "Field2", "Field1"); got != updates {
+		t.Errorf("Patch(Field2, Field1) = %+v, want %+v", got, updates)
+	}
+	if base.Field1 != "hello" {
+		t.Error("Patch modified base")
+	}
+}
+
+func TestPatchNilMask(t *testing.T) {
+	base := MyStruct{"a", 1}
+	updates := MyStruct{"b", 2}
+	if got := Patch(base, updates); got != updates {
+		t.Errorf("Patch without mask = %+v, want %+v", got, updates)
+	}
+	if got := Patch(base, updates, nil...); got != updates {
+		t.Errorf("Patch with a nil mask = %+v, want %+v", got, updates)
+	}
+}
+
+func TestPatchOverlapping(t *testing.T) {
+	base := Record{Address: Address{City: "Berlin", Zip: "10115"}}
+	updates := Record{Address: Address{City: "Hamburg", Zip: "20095"}}
+	// The whole struct and one of its fields, in both orders.
+	for _, mask := range [][]string{{"Address", "Address.Zip"}, {"Address.Zip", "Address"}} {
+		if got := Patch(base, updates, mask...); got.Address != updates.Address {
+			t.Errorf("Patch(%v) = %+v, want %+v", mask, got.Address, updates.Address)
+		}
+	}
+	got := Patch(base, updates, "Address.Zip", "Address.Zip")
+	if want := (Address{City: "Berlin", Zip: "20095"}); got.Address != want {
+		t.Errorf("Patch with a repeated name = %+v, want %+v", got.Address, want)
+	}
+}
+
+func TestPatchEmbedded(t *testing.T) {
+	base := Record{MyStruct: MyStruct{"a", 1}, Meta: &Meta{Version: 1, Tags: []string{"x"}}}
+	updates := Record{MyStruct: MyStruct{"b", 2}, Meta: &Meta{Version: 2}}
+
+	got := Patch(base, updates, "Field1", "Version")
+	if got.Field1 != "b" || got.Field2 != 1 {
+		t.Errorf("promoted fields after Patch = %+v, want {b 1}", got.MyStruct)
+	}
+	if got.Version != 2 || !reflect.DeepEqual(got.Tags, []string{"x"}) {
+		t.Errorf("fields behind the embedded pointer = %+v, want {2 [x]}", *got.Meta)
+	}
+	if base.Meta.Version != 1 {
+		t.Error("Patch modified the struct behind the embedded pointer of base")
+	}
+	if got.Meta == base.Meta {
+		t.Error("Patch did not copy the struct behind the embedded pointer")
+	}
+
+	if got := Patch(base, updates, "MyStruct"); got.MyStruct != updates.MyStruct {
+		t.Errorf("Patch(MyStruct) = %+v, want %+v", got.MyStruct, updates.MyStruct)
+	}
+}
+
+func TestPatchPanics(t *testing.T) {
+	for name, f := range map[string]func(){
+		"unknown field":       func() { Patch(MyStruct{}, MyStruct{}, "Field3") },
+		"unexported field":    func() { Patch(Record{}, Record{}, "private") },
+		"not a struct":        func() { Patch(1, 2, "X") },
+		"path into a string":  func() { Patch(MyStruct{}, MyStruct{}, "Field1.X") },
+		"nil embedded source": func() { Patch(Record{Meta: &Meta{}}, Record{}, "Version") },
+		"nil embedded base":   func() { Patch(Record{}, Record{Meta: &Meta{}}, "Version") },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: Patch did not panic", name)
+				}
+			}()
+			f()
+		}()
+	}
+}
+
+func TestPatchFn(t *testing.T) {
+	update := PatchFn(
+		Set(func(s *MyStruct) *string { return &s.Field1 }, "world"),
+		func(s *MyStruct) { s.Field2 *= 2 },
+	)
+	base := MyStruct{"hello", 10}
+	if got, want := update(base), (MyStruct{"world", 20}); got != want {
+		t.Errorf("PatchFn = %+v, want %+v", got, want)
+	}
+	if base.Field1 != "hello" {
+		t.Error("PatchFn modified its argument")
+	}
+	if got := PatchFn[MyStruct]()(base); got != base {
+		t.Errorf("empty PatchFn = %+v, want %+v", got, base)
+	}
+}
+
+func BenchmarkPatch(b *testing.B) {
+	base, updates := MyStruct{"a", 1}, MyStruct{"b", 2}
+	for range b.N {
+		base = Patch(base, updates, "Field1")
+	}
+}
+
+func BenchmarkPatchFn(b *testing.B) {
+	base := MyStruct{"a", 1}
+	update := PatchFn(Set(func(s *MyStruct) *string { return &s.Field1 }, "b"))
+	for range b.N {
+		base = update(base)
+	}
+}
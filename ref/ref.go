@@ -0,0 +1,68 @@
+// Package ref provides a nullable reference that is a plain value type.
+package ref
+
+// Ref either contains a value or is empty. Unlike *T it never needs a nil
+// check, and a Ref is comparable whenever T is, so it can be used as a map
+// key. The zero value is the empty Ref.
+type Ref[T any] struct {
+	value   T
+	present bool
+}
+
+// NewRef returns a Ref containing v.
+func NewRef[T any](v T) Ref[T] {
+	return Ref[T]{value: v, present: true}
+}
+
+// EmptyRef returns an empty Ref.
+func EmptyRef[T any]() Ref[T] {
+	return Ref[T]{}
+}
+
+// NonZero returns a Ref containing v, or an empty Ref if v is the zero value
+// of T, such as a nil pointer or interface.
+func NonZero[T comparable](v T) Ref[T] {
+	var zero T
+	if v == zero {
+		return Ref[T]{}
+	}
+	return NewRef(v)
+}
+
+// IsPresent reports whether r contains a value.
+func (r Ref[T]) IsPresent() bool {
+	return r.present
+}
+
+// Value returns the contained value. It panics if r is empty.
+func (r Ref[T]) Value() T {
+	if !r.present {
+		panic("ref: Value of empty Ref")
+	}
+	return r.value
+}
+
+// ValueOr returns the contained value, or def if r is empty.
+func (r Ref[T]) ValueOr(def T) T {
+	if !r.present {
+		return def
+	}
+	return r.value
+}
+
+// Map applies f to the value of r. An empty Ref stays empty.
+func Map[T, U any](r Ref[T], f func(T) U) Ref[U] {
+	if !r.present {
+		return Ref[U]{}
+	}
+	return NewRef(f(r.value))
+}
+
+// FlatMap applies f to the value of r and returns its result. An empty Ref
+// stays empty.
+func FlatMap[T, U any](r Ref[T], f func(T) Ref[U]) Ref[U] {
+	if !r.present {
+		return Ref[U]{}
+	}
+	return f(r.value)
+}
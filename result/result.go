@@ -0,0 +1,54 @@
+// Package result provides a generic type carrying either a value or an error.
+package result
+
+// Result holds either a value of type T or an error.
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// OK returns a successful Result holding v.
+func OK[T any](v T) Result[T] {
+	return Result[T]{value: v}
+}
+
+// Err returns a failed Result holding err.
+func Err[T any](err error) Result[T] {
+	return Result[T]{err: err}
+}
+
+// IsOK reports whether r holds a value rather than an error.
+func (r Result[T]) IsOK() bool {
+	return r.err == nil
+}
+
+// Unwrap returns the held value. It panics if r holds an error.
+func (r Result[T]) Unwrap() T {
+	if r.err != nil {
+		panic(r.err)
+	}
+	return r.value
+}
+
+// TryUnwrap returns the held value and error.
+func (r Result[T]) TryUnwrap() (T, error) {
+	return r.value, r.err
+}
+
+// Map applies f to the value held by r. A failed Result is passed through
+// unchanged.
+func Map[T, U any](r Result[T], f func(T) U) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return OK(f(r.value))
+}
+
+// AndThen applies f to the value held by r and returns its Result. A failed
+// Result is passed through unchanged.
+func AndThen[T, U any](r Result[T], f func(T) Result[U]) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return f(r.value)
+}
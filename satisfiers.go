@@ -0,0 +1,124 @@
+package main
+
+import (
+	"fmt"
+	"go/token"
+	"go/types"
+	"strings"
+)
+
+// ConstraintMatch describes how a type relates to a constraint. Satisfies
+// is the authoritative answer of go/types; ByMethods and ByTypeSet tell
+// which half of the constraint the type fulfills on its own.
+type ConstraintMatch struct {
+	Name        string
+	Type        types.Type
+	Satisfies   bool
+	ByMethods   bool // Has all methods of the constraint
+	ByTypeSet   bool // Matches a term of the constraint, or the constraint has no terms
+	IsTypeParam bool
+}
+
+// AnalyzeConstraint checks every type declared in pkg, including local types
+// and type parameters, against constraint. Generic types are skipped since
+// they cannot be used without instantiation.
+func AnalyzeConstraint(pkg *types.Package, constraint *types.Interface) []ConstraintMatch {
+	terms := constraintTerms(constraint)
+	var matches []ConstraintMatch
+	var walk func(s *types.Scope)
+	walk = func(s *types.Scope) {
+		for _, name := range s.Names() {
+			tn, ok := s.Lookup(name).(*types.TypeName)
+			if !ok {
+				continue
+			}
+			if named, ok := tn.Type().(*types.Named); ok && named.TypeParams() != nil {
+				continue
+			}
+			t := tn.Type()
+			_, isTypeParam := t.(*types.TypeParam)
+			if iface, ok := t.Underlying().(*types.Interface); ok && !isTypeParam && !iface.IsMethodSet() {
+				continue // Constraint interfaces are not types
+			}
+			method, _ := types.MissingMethod(t, constraint, true)
+			matches = append(matches, ConstraintMatch{
+				Name:        name,
+				Type:        t,
+				Satisfies:   types.Satisfies(t, constraint),
+				ByMethods:   method == nil,
+				ByTypeSet:   len(terms) == 0 || matchesTerm(t, terms),
+				IsTypeParam: isTypeParam,
+			})
+		}
+		for i := range s.NumChildren() {
+			walk(s.Child(i))
+		}
+	}
+	walk(pkg.Scope())
+	return matches
+}
+
+// matchesTerm reports whether t is in the union of terms. A type parameter
+// matches if every term of its own constraint does.
+func matchesTerm(t types.Type, terms []*types.Term) bool {
+	if tp, ok := t.(*types.TypeParam); ok {
+		own := constraintTerms(tp.Constraint().Underlying().(*types.Interface))
+		if len(own) == 0 {
+			return false
+		}
+		for _, term := range own {
+			if !matchesTerm(term.Type(), terms) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, term := range terms {
+		if term.Tilde() && types.Identical(t.Underlying(), term.Type().Underlying()) {
+			return true
+		}
+		if !term.Tilde() && types.Identical(t, term.Type()) {
+			return true
+		}
+	}
+	return false
+}
+
+// FindSatisfyingTypes returns the types declared in pkg that satisfy
+// constraint.
+func FindSatisfyingTypes(pkg *types.Package, constraint *types.Interface) []types.Type {
+	var found []types.Type
+	for _, m := range AnalyzeConstraint(pkg, constraint) {
+		if m.Satisfies {
+			found = append(found, m.Type)
+		}
+	}
+	return found
+}
+
+// satisfiers lists the declared types that can be used for a constraint.
+func satisfiers(c checkedPackage, pos token.Pos, scope *types.Scope, args string) string {
+	name := strings.TrimSpace(args)
+	constraint, ok := lookupType(c.pkg, scope, pos, name)
+	if !ok {
+		return fmt.Sprintf("\t<constraint %s not found>\n", name)
+	}
+	iface, ok := constraint.Underlying().(*types.Interface)
+	if !ok {
+		return fmt.Sprintf("\t<%s is not an interface>\n", name)
+	}
+
+	buff := &strings.Builder{}
+	for _, m := range AnalyzeConstraint(c.pkg, iface) {
+		mark := "✗"
+		if m.Satisfies {
+			mark = "✓"
+		}
+		fmt.Fprintf(buff, "\t%s %s\tmethods: %v, type set: %v", mark, m.Type, m.ByMethods, m.ByTypeSet)
+		if m.IsTypeParam {
+			buff.WriteString(", type parameter")
+		}
+		buff.WriteString("\n")
+	}
+	return buff.String()
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"go/types"
+	"strings"
+	"testing"
+)
+
+const satisfiersSource = `package main
+
+import "strconv"
+
+type MyInt int
+
+func (m MyInt) String() string { return strconv.Itoa(int(m)) }
+
+type PlainInt int
+
+type MyFloat float64
+
+type Wrapper struct{ v int }
+
+func (Wrapper) String() string { return "" }
+
+type Box[E any] struct{ v E }
+
+type StringerInt interface {
+	~int
+	String() string
+}
+
+type Integer interface{ ~int | ~int64 }
+
+func twice[T Integer](v T) T { return v * 2 }
+`
+
+func constraintOf(t *testing.T, c checkedPackage, name string) *types.Interface {
+	t.Helper()
+	obj := c.pkg.Scope().Lookup(name)
+	if obj == nil {
+		t.Fatalf("%s not found", name)
+	}
+	return obj.Type().Underlying().(*types.Interface)
+}
+
+func TestAnalyzeConstraint(t *testing.T) {
+	c := checkSource(t, satisfiersSource)
+	got := make(map[string]ConstraintMatch)
+	for _, m := range AnalyzeConstraint(c.pkg, constraintOf(t, c, "StringerInt")) {
+		got[m.Name] = m
+	}
+	for _, want := range []ConstraintMatch{
+		{Name: "MyInt", Satisfies: true, ByMethods: true, ByTypeSet: true},
+		{Name: "PlainInt", ByTypeSet: true},
+		{Name: "Wrapper", ByMethods: true},
+		{Name: "MyFloat"},
+		{Name: "T", IsTypeParam: true, ByTypeSet: false},
+	} {
+		m, ok := got[want.Name]
+		if !ok {
+			t.Errorf("%s was not analyzed", want.Name)
+			continue
+		}
+		m.Type = nil
+		if m != want {
+			t.Errorf("%s: got %+v, want %+v", want.Name, m, want)
+		}
+	}
+	for _, skipped := range []string{"Box", "StringerInt", "Integer"} {
+		if _, ok := got[skipped]; ok {
+			t.Errorf("%s was analyzed, generic types and constraints should be skipped", skipped)
+		}
+	}
+}
+
+func TestAnalyzeConstraintTypeParam(t *testing.T) {
+	c := checkSource(t, satisfiersSource)
+	for _, m := range AnalyzeConstraint(c.pkg, constraintOf(t, c, "Integer")) {
+		if m.Name == "T" && (!m.IsTypeParam || !m.Satisfies || !m.ByTypeSet) {
+			t.Errorf("type parameter T of twice: got %+v, want it to satisfy Integer by its type set", m)
+		}
+	}
+}
+
+func TestFindSatisfyingTypes(t *testing.T) {
+	c := checkSource(t, satisfiersSource)
+	var names []string
+	for _, typ := range FindSatisfyingTypes(c.pkg, constraintOf(t, c, "Integer")) {
+		names = append(names, types.TypeString(typ, types.RelativeTo(c.pkg)))
+	}
+	if got, want := strings.Join(names, " "), "MyInt PlainInt T"; got != want {
+		t.Errorf("FindSatisfyingTypes(Integer) = %s, want %s", got, want)
+	}
+}
+
+func TestSatisfiersDirective(t *testing.T) {
+	out := inspect(t, satisfiersSource+"\n// satisfiers: StringerInt\nfunc main() {}\n")
+	for _, want := range []string{"✓ main.MyInt", "✗ main.PlainInt", "✗ main.Wrapper"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output does not contain %q:\n%s", want, out)
+		}
+	}
+}
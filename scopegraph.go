@@ -0,0 +1,40 @@
+package main
+
+import (
+	"fmt"
+	"go/token"
+	"go/types"
+	"io"
+	"strings"
+)
+
+// WriteScopeGraph writes the scope tree of pkg in Graphviz DOT format. Every
+// node is a scope labelled with its kind, source range and declared names;
+// edges point from parent to child scopes.
+func WriteScopeGraph(w io.Writer, fset *token.FileSet, pkg *types.Package) error {
+	buff := &strings.Builder{}
+	buff.WriteString("digraph scopes {\n\tnode [shape=box];\n")
+	next := 0
+	var walk func(s *types.Scope) int
+	walk = func(s *types.Scope) int {
+		id := next
+		next++
+		label := scopeKind(pkg, s) + " scope"
+		if s.Pos().IsValid() {
+			label += fmt.Sprintf("\n%s - %s", fset.Position(s.Pos()), fset.Position(s.End()))
+		}
+		for _, name := range s.Names() {
+			label += "\n" + name
+		}
+		fmt.Fprintf(buff, "\ts%d [label=%q];\n", id, label)
+		for i := range s.NumChildren() {
+			child := walk(s.Child(i))
+			fmt.Fprintf(buff, "\ts%d -> s%d;\n", id, child)
+		}
+		return id
+	}
+	walk(pkg.Scope())
+	buff.WriteString("}\n")
+	_, err := io.WriteString(w, buff.String())
+	return err
+}
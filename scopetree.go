@@ -0,0 +1,57 @@
+package main
+
+import (
+	"fmt"
+	"go/token"
+	"go/types"
+	"slices"
+	"strings"
+
+	"projektarbeit-go-generics/ref"
+)
+
+// scopeTree prints the chain of scopes enclosing pos, from the package scope
+// down to the innermost one, with every name declared at each level. Every
+// scope is indented by its depth below the universe scope, so it appears
+// under its parent.
+func scopeTree(c checkedPackage, pos token.Pos, scope *types.Scope, _ string) string {
+	var chain []*types.Scope
+	for s := scope; s != nil && s != types.Universe; s = s.Parent() {
+		chain = append(chain, s)
+	}
+	slices.Reverse(chain)
+
+	buff := &strings.Builder{}
+	for depth, s := range chain {
+		indent := strings.Repeat("  ", depth) // The package scope is at depth 0
+		fmt.Fprintf(buff, "%s%s scope", indent, scopeKind(c.pkg, s))
+		if s.Pos().IsValid() {
+			fmt.Fprintf(buff, " %s - %s", c.fset.Position(s.Pos()), c.fset.Position(s.End()))
+		}
+		buff.WriteString("\n")
+		for _, name := range s.Names() {
+			obj := s.Lookup(name)
+			note := ""
+			if s != c.pkg.Scope() && obj.Pos() > pos {
+				note = " (declared after this comment)"
+			}
+			fmt.Fprintf(buff, "%s  %s%s\n", indent, name, note)
+			for _, line := range strings.SplitAfter(strings.TrimSuffix(formatObj(c.fset, ref.NewRef(obj)), "\n"), "\n") {
+				fmt.Fprintf(buff, "%s  %s", indent, line)
+			}
+			buff.WriteString("\n")
+		}
+	}
+	return buff.String()
+}
+
+func scopeKind(pkg *types.Package, s *types.Scope) string {
+	switch {
+	case s == pkg.Scope():
+		return "package"
+	case s.Parent() == pkg.Scope():
+		return "file"
+	default:
+		return "block"
+	}
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestScopeTreeNesting(t *testing.T) {
+	out := inspect(t, `package main
+
+var global int
+
+func main() {
+	outer := 1
+	if inner := outer; inner > 0 {
+		// scopetree:
+		_ = inner
+	}
+	after := 2
+	_ = after
+}
+`)
+	// Keep only the scope headers and the names declared in them.
+	var got []string
+	for _, line := range strings.Split(out, "\n") {
+		trimmed := strings.TrimLeft(line, " ")
+		if trimmed == "" || strings.HasPrefix(trimmed, "\t") || strings.Contains(line, "scopetree") {
+			continue
+		}
+		if i := strings.Index(line, " scope"); i >= 0 {
+			line = line[:i+len(" scope")]
+		}
+		got = append(got, line)
+	}
+	want := []string{
+		"package scope",
+		"  global",
+		"  main",
+		"  file scope",
+		"    block scope",
+		"      after (declared after this comment)",
+		"      outer",
+		"      block scope",
+		"        inner",
+		"        block scope",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("scope tree =\n%s\nwant\n%s\nfull output:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"), out)
+	}
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"fmt"
+	"go/token"
+	"go/types"
+	"runtime"
+	"strings"
+)
+
+var sizes = types.SizesFor("gc", runtime.GOARCH)
+
+type selection struct {
+	isMethod  bool
+	index     []int
+	promoted  bool
+	indirect  bool
+	offset    int64
+	hasOffset bool
+}
+
+// lookupSelector resolves names like s.Field1 by looking up the leftmost
+// name in scope and every following part with types.LookupFieldOrMethod.
+// Qualified identifiers like fmt.Println are resolved in the imported package.
+func lookupSelector(pkg *types.Package, scope *types.Scope, pos token.Pos, name string) (types.Object, *selection) {
+	parts := strings.Split(name, ".")
+	_, obj := scope.LookupParent(parts[0], pos)
+	rest := parts[1:]
+	if pkgName, ok := obj.(*types.PkgName); ok && len(rest) > 0 {
+		obj = pkgName.Imported().Scope().Lookup(rest[0])
+		rest = rest[1:]
+	}
+	if obj == nil || len(rest) == 0 {
+		return obj, nil
+	}
+
+	sel := &selection{hasOffset: true}
+	t := obj.Type()
+	for i, part := range rest {
+		if sel.isMethod {
+			return nil, nil
+		}
+		if _, ok := t.Underlying().(*types.Pointer); ok && i > 0 {
+			sel.hasOffset = false // The field lives in a different allocation
+		}
+		found, index, indirect := types.LookupFieldOrMethod(t, true, pkg, part)
+		if found == nil {
+			return nil, nil
+		}
+		if _, ok := found.(*types.Func); ok {
+			sel.isMethod = true
+			sel.hasOffset = false
+		} else if offset, ok := fieldOffset(t, index); ok && sel.hasOffset {
+			sel.offset += offset
+		} else {
+			sel.hasOffset = false
+		}
+		sel.index = append(sel.index, index...)
+		sel.promoted = sel.promoted || len(index) > 1
+		sel.indirect = sel.indirect || indirect
+		obj = found
+		t = found.Type()
+	}
+	return obj, sel
+}
+
+// fieldOffset sums the byte offsets along index starting at struct type t.
+// It fails if the path leaves the struct through a pointer.
+func fieldOffset(t types.Type, index []int) (int64, bool) {
+	if p, ok := t.Underlying().(*types.Pointer); ok {
+		t = p.Elem()
+	}
+	var offset int64
+	for i, idx := range index {
+		if i > 0 {
+			if _, ok := t.Underlying().(*types.Pointer); ok {
+				return 0, false
+			}
+		}
+		st, ok := t.Underlying().(*types.Struct)
+		if !ok {
+			return 0, false
+		}
+		fields := make([]*types.Var, st.NumFields())
+		for j := range fields {
+			fields[j] = st.Field(j)
+		}
+		offset += sizes.Offsetsof(fields)[idx]
+		t = st.Field(idx).Type()
+	}
+	return offset, true
+}
+
+func formatSelection(sel *selection) string {
+	buff := &strings.Builder{}
+	if sel.isMethod {
+		fmt.Fprintf(buff, "\tSelection: method\n")
+	} else {
+		fmt.Fprintf(buff, "\tSelection: field\n")
+	}
+	fmt.Fprintf(buff, "\tIndex Path: %v\n", sel.index)
+	fmt.Fprintf(buff, "\tPromoted: %v\n", sel.promoted)
+	fmt.Fprintf(buff, "\tIndirect: %v\n", sel.indirect)
+	if sel.hasOffset {
+		fmt.Fprintf(buff, "\tOffset: %d\n", sel.offset)
+	}
+	return buff.String()
+}
+
+func marshalSelection(sel *selection) map[string]any {
+	m := map[string]any{
+		"isMethod": sel.isMethod,
+		"index":    sel.index,
+		"promoted": sel.promoted,
+		"indirect": sel.indirect,
+		"offset":   nil,
+	}
+	if sel.hasOffset {
+		m["offset"] = sel.offset
+	}
+	return m
+}
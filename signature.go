@@ -0,0 +1,89 @@
+package main
+
+import (
+	"go/token"
+	"go/types"
+	"strings"
+)
+
+// FormatOpts controls FormatSignature.
+type FormatOpts struct {
+	Name         string         // Function name to print after func, if any
+	ShowReceiver bool           // Print the receiver of methods
+	Pkg          *types.Package // Package the types are spelled relative to
+	TypeNames    TypeNameOptions
+}
+
+// FormatSignature renders sig the way it would be declared, e.g.
+// "func isEven(n MyInt) bool" or "func (s MyStruct) String() string",
+// including type parameters and variadic parameters.
+func FormatSignature(sig *types.Signature, _ *token.FileSet, opts FormatOpts) string {
+	typ := func(t types.Type) string {
+		return opts.TypeNames.Normalize(t, opts.Pkg)
+	}
+
+	buff := &strings.Builder{}
+	buff.WriteString("func")
+	if recv := sig.Recv(); opts.ShowReceiver && recv != nil {
+		buff.WriteString(" (")
+		if recv.Name() != "" && recv.Name() != "_" {
+			buff.WriteString(recv.Name() + " ")
+		}
+		buff.WriteString(typ(recv.Type()) + ")")
+	}
+	if opts.Name != "" {
+		buff.WriteString(" " + opts.Name)
+	}
+	if tparams := sig.TypeParams(); tparams != nil {
+		buff.WriteString("[")
+		for i := range tparams.Len() {
+			if i > 0 {
+				buff.WriteString(", ")
+			}
+			// Constraints keep their aliases so any is not spelled interface{}
+			tp, constraintOpts := tparams.At(i), opts.TypeNames
+			constraintOpts.ExpandAliases = false
+			buff.WriteString(tp.Obj().Name() + " " + constraintOpts.Normalize(tp.Constraint(), opts.Pkg))
+		}
+		buff.WriteString("]")
+	}
+
+	buff.WriteString("(")
+	params := sig.Params()
+	for i := range params.Len() {
+		if i > 0 {
+			buff.WriteString(", ")
+		}
+		p := params.At(i)
+		if p.Name() != "" {
+			buff.WriteString(p.Name() + " ")
+		}
+		if sig.Variadic() && i == params.Len()-1 {
+			buff.WriteString("..." + typ(p.Type().(*types.Slice).Elem()))
+		} else {
+			buff.WriteString(typ(p.Type()))
+		}
+	}
+	buff.WriteString(")")
+
+	results := sig.Results()
+	switch {
+	case results.Len() == 0:
+	case results.Len() == 1 && results.At(0).Name() == "":
+		buff.WriteString(" " + typ(results.At(0).Type()))
+	default:
+		buff.WriteString(" (")
+		for i := range results.Len() {
+			if i > 0 {
+				buff.WriteString(", ")
+			}
+			r := results.At(i)
+			if r.Name() != "" {
+				buff.WriteString(r.Name() + " ")
+			}
+			buff.WriteString(typ(r.Type()))
+		}
+		buff.WriteString(")")
+	}
+	return buff.String()
+}
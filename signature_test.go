@@ -0,0 +1,70 @@
+package main
+
+import (
+	"go/types"
+	"strings"
+	"testing"
+)
+
+const signatureSource = `package main
+
+import "io"
+
+type Stack[T any] struct{ items []T }
+
+func (s *Stack[T]) Push(v T)       { s.items = append(s.items, v) }
+func (MyStruct) String() string     { return "" }
+func join(sep string, parts ...MyInt) string { return "" }
+func divide(a, b int) (q, r int)   { return a / b, a % b }
+func open() (io.Reader, error)     { return nil, nil }
+func mapKeys[K comparable, V any](m map[K]V) []K { return nil }
+func sum[N ~int | ~float64](ns ...N) N { return 0 }
+`
+
+func TestFormatSignature(t *testing.T) {
+	example := strings.TrimPrefix(readExample(t, "example1.go.e"), "package main\n")
+	c := checkSource(t, signatureSource+example)
+	scope := c.pkg.Scope()
+	funcSig := func(name string) *types.Signature {
+		return scope.Lookup(name).Type().(*types.Signature)
+	}
+	methodSig := func(typeName, method string) *types.Signature {
+		obj, _, _ := types.LookupFieldOrMethod(types.NewPointer(scope.Lookup(typeName).Type()), false, c.pkg, method)
+		return obj.Type().(*types.Signature)
+	}
+	short := func(name string) FormatOpts {
+		return FormatOpts{Name: name, ShowReceiver: true, Pkg: c.pkg, TypeNames: DefaultTypeNameOptions}
+	}
+
+	tests := []struct {
+		sig  *types.Signature
+		opts FormatOpts
+		want string
+	}{
+		{funcSig("isEven"), short("isEven"), "func isEven(n MyInt) bool"},
+		{funcSig("isEven"), FormatOpts{Pkg: c.pkg}, "func(n main.MyInt) bool"},
+		{funcSig("join"), short("join"), "func join(sep string, parts ...MyInt) string"},
+		{funcSig("divide"), short("divide"), "func divide(a int, b int) (q int, r int)"},
+		{funcSig("open"), short("open"), "func open() (io.Reader, error)"},
+		{funcSig("mapKeys"), short("mapKeys"), "func mapKeys[K comparable, V any](m map[K]V) []K"},
+		{funcSig("sum"), short("sum"), "func sum[N ~int | ~float64](ns ...N) N"},
+		{methodSig("MyStruct", "String"), short("String"), "func (MyStruct) String() string"},
+		{methodSig("Stack", "Push"), short("Push"), "func (s *Stack[T]) Push(v T)"},
+		{methodSig("Stack", "Push"), FormatOpts{Name: "Push", Pkg: c.pkg, TypeNames: DefaultTypeNameOptions}, "func Push(v T)"},
+	}
+	for _, tt := range tests {
+		if got := FormatSignature(tt.sig, c.fset, tt.opts); got != tt.want {
+			t.Errorf("FormatSignature(%s) = %q, want %q", tt.sig, got, tt.want)
+		}
+	}
+}
+
+func TestShortFuncSignature(t *testing.T) {
+	defer func(old bool) { *short = old }(*short)
+	*short = true
+	src := strings.Replace(readExample(t, "example1.go.e"), "// inspect: MyStruct, 1, s, s.Field1", "// inspect: isEven", 1)
+	out := inspect(t, src)
+	if want := "\tFunc Signature: func isEven(n MyInt) bool\n"; !strings.Contains(out, want) {
+		t.Errorf("output does not contain %q:\n%s", want, out)
+	}
+}
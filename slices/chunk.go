@@ -0,0 +1,32 @@
+package slices
+
+import "fmt"
+
+// Chunk splits s into consecutive sub-slices of length size. The last chunk
+// may be shorter. The chunks share the backing array of s, but their
+// capacity is capped so appending to one chunk does not overwrite the next.
+// Chunk panics if size is not positive.
+func Chunk[T any](s []T, size int) [][]T {
+	if size <= 0 {
+		panic(fmt.Sprintf("slices: Chunk size must be positive, got %d", size))
+	}
+	chunks := make([][]T, 0, (len(s)+size-1)/size)
+	for i := 0; i < len(s); i += size {
+		end := i + size
+		if end > len(s) {
+			end = len(s)
+		}
+		chunks = append(chunks, s[i:end:end])
+	}
+	return chunks
+}
+
+// ChunkCopy is like Chunk but every chunk is a copy that does not share
+// memory with s.
+func ChunkCopy[T any](s []T, size int) [][]T {
+	chunks := Chunk(s, size)
+	for i, c := range chunks {
+		chunks[i] = append([]T(nil), c...)
+	}
+	return chunks
+}
@@ -0,0 +1,75 @@
+package slices
+
+// SetThreshold is the length of s above which ContainsAllSet and
+// ContainsAnySet build a set from s instead of scanning it repeatedly.
+const SetThreshold = 32
+
+// Contains reports whether elem is present in s.
+func Contains[T comparable](s []T, elem T) bool {
+	for _, v := range s {
+		if v == elem {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsAll reports whether every element of elems is present in s. It
+// runs in O(len(s)·len(elems)).
+func ContainsAll[T comparable](s []T, elems ...T) bool {
+	for _, e := range elems {
+		if !Contains(s, e) {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsAny reports whether at least one element of elems is present in s.
+// It runs in O(len(s)·len(elems)).
+func ContainsAny[T comparable](s []T, elems ...T) bool {
+	for _, e := range elems {
+		if Contains(s, e) {
+			return true
+		}
+	}
+	return false
+}
+
+func toSet[T comparable](s []T) map[T]struct{} {
+	set := make(map[T]struct{}, len(s))
+	for _, v := range s {
+		set[v] = struct{}{}
+	}
+	return set
+}
+
+// ContainsAllSet is like ContainsAll but converts s into a set first if it
+// is longer than SetThreshold, which makes it O(len(s)+len(elems)).
+func ContainsAllSet[T comparable](s []T, elems ...T) bool {
+	if len(s) <= SetThreshold {
+		return ContainsAll(s, elems...)
+	}
+	set := toSet(s)
+	for _, e := range elems {
+		if _, ok := set[e]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsAnySet is like ContainsAny but converts s into a set first if it
+// is longer than SetThreshold, which makes it O(len(s)+len(elems)).
+func ContainsAnySet[T comparable](s []T, elems ...T) bool {
+	if len(s) <= SetThreshold {
+		return ContainsAny(s, elems...)
+	}
+	set := toSet(s)
+	for _, e := range elems {
+		if _, ok := set[e]; ok {
+			return true
+		}
+	}
+	return false
+}
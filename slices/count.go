@@ -0,0 +1,45 @@
+package slices
+
+import (
+	"sort"
+
+	"projektarbeit-go-generics/tuple"
+)
+
+// CountBy counts how many elements of s map to each key.
+func CountBy[T any, K comparable](s []T, key func(T) K) map[K]int {
+	counts := make(map[K]int, len(s))
+	for _, v := range s {
+		counts[key(v)]++
+	}
+	return counts
+}
+
+// Count counts how often each element occurs in s.
+func Count[T comparable](s []T) map[T]int {
+	counts := make(map[T]int, len(s))
+	for _, v := range s {
+		counts[v]++
+	}
+	return counts
+}
+
+// MostCommon returns the n most frequent elements of s with their counts,
+// most frequent first. Elements with equal counts keep the order of their
+// first occurrence in s. A negative n or one larger than the number of
+// distinct elements returns all of them.
+func MostCommon[T comparable](s []T, n int) []tuple.Pair[T, int] {
+	counts := Count(s)
+	pairs := make([]tuple.Pair[T, int], 0, len(counts))
+	for _, v := range s {
+		if c, ok := counts[v]; ok {
+			pairs = append(pairs, tuple.NewPair(v, c))
+			delete(counts, v)
+		}
+	}
+	sort.SliceStable(pairs, func(i, j int) bool { return pairs[i].Second > pairs[j].Second })
+	if n >= 0 && n < len(pairs) {
+		pairs = pairs[:n]
+	}
+	return pairs
+}
@@ -0,0 +1,29 @@
+package slices
+
+// Filter returns a new slice containing the elements of s for which
+// predicate returns true. The result never shares memory with s.
+func Filter[T any](s []T, predicate func(T) bool) []T {
+	var result []T
+	for _, v := range s {
+		if predicate(v) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// FilterInPlace removes all elements from *s for which predicate returns
+// false. It reuses the backing array of *s and does not allocate.
+func FilterInPlace[T any](s *[]T, predicate func(T) bool) {
+	kept := (*s)[:0]
+	for _, v := range *s {
+		if predicate(v) {
+			kept = append(kept, v)
+		}
+	}
+	var zero T
+	for i := len(kept); i < len(*s); i++ {
+		(*s)[i] = zero // Clear the tail so dropped elements can be collected
+	}
+	*s = kept
+}
@@ -0,0 +1,116 @@
+package slices
+
+import (
+	"reflect"
+	"testing"
+)
+
+func even(v int) bool { return v%2 == 0 }
+
+func TestFilter(t *testing.T) {
+	in := []int{1, 2, 3, 4, 5, 6}
+	got := Filter(in, even)
+	if want := []int{2, 4, 6}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Filter = %v, want %v", got, want)
+	}
+	if want := []int{1, 2, 3, 4, 5, 6}; !reflect.DeepEqual(in, want) {
+		t.Errorf("Filter modified its input to %v", in)
+	}
+}
+
+func TestFilterSharesNoMemory(t *testing.T) {
+	// All elements match, so a result aliasing the input would be easy to
+	// produce by accident.
+	in := []int{2, 4, 6}
+	got := Filter(in, even)
+	got[0] = 100
+	if in[0] != 2 {
+		t.Errorf("writing to the result of Filter changed the input to %v", in)
+	}
+}
+
+func TestFilterInPlace(t *testing.T) {
+	backing := []*int{new(int), new(int), new(int), new(int)}
+	for i, p := range backing {
+		*p = i
+	}
+	s := backing
+	FilterInPlace(&s, func(p *int) bool { return even(*p) })
+	if len(s) != 2 || *s[0] != 0 || *s[1] != 2 {
+		t.Errorf("FilterInPlace kept %d elements, want the values 0 and 2", len(s))
+	}
+	if &s[0] != &backing[0] {
+		t.Error("FilterInPlace did not reuse the backing array")
+	}
+	for i := len(s); i < len(backing); i++ {
+		if backing[i] != nil {
+			t.Errorf("backing[%d] = %v after FilterInPlace, want nil", i, backing[i])
+		}
+	}
+}
+
+func TestFilterInPlaceAllocs(t *testing.T) {
+	s := make([]int, 64)
+	if allocs := testing.AllocsPerRun(100, func() {
+		s = s[:cap(s)]
+		FilterInPlace(&s, even)
+	}); allocs != 0 {
+		t.Errorf("FilterInPlace allocated %v times, want 0", allocs)
+	}
+}
+
+func benchmarkInput() []int {
+	s := make([]int, 1024)
+	for i := range s {
+		s[i] = i
+	}
+	return s
+}
+
+func BenchmarkFilter(b *testing.B) {
+	in := benchmarkInput()
+	for i := 0; i < b.N; i++ {
+		sink = Filter(in, even)
+	}
+}
+
+func BenchmarkFilterLoop(b *testing.B) {
+	in := benchmarkInput()
+	for i := 0; i < b.N; i++ {
+		var result []int
+		for _, v := range in {
+			if even(v) {
+				result = append(result, v)
+			}
+		}
+		sink = result
+	}
+}
+
+func BenchmarkFilterInPlace(b *testing.B) {
+	in := benchmarkInput()
+	s := make([]int, len(in))
+	for i := 0; i < b.N; i++ {
+		s = s[:len(in)]
+		copy(s, in)
+		FilterInPlace(&s, even)
+	}
+	sink = s
+}
+
+func BenchmarkFilterInPlaceLoop(b *testing.B) {
+	in := benchmarkInput()
+	s := make([]int, len(in))
+	for i := 0; i < b.N; i++ {
+		s = s[:len(in)]
+		copy(s, in)
+		kept := s[:0]
+		for _, v := range s {
+			if even(v) {
+				kept = append(kept, v)
+			}
+		}
+		s = kept
+	}
+	sink = s
+}
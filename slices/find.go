@@ -0,0 +1,40 @@
+package slices
+
+import "projektarbeit-go-generics/optional"
+
+// Find returns the first element of s for which pred returns true. It
+// returns the zero value and false if there is none.
+func Find[T any](s []T, pred func(T) bool) (T, bool) {
+	if i := IndexWhere(s, pred); i >= 0 {
+		return s[i], true
+	}
+	var zero T
+	return zero, false
+}
+
+// FindLast returns the last element of s for which pred returns true. It
+// returns the zero value and false if there is none.
+func FindLast[T any](s []T, pred func(T) bool) (T, bool) {
+	for i := len(s) - 1; i >= 0; i-- {
+		if pred(s[i]) {
+			return s[i], true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// FindIndex returns the index of the first element of s for which pred
+// returns true, or -1 if there is none. It is an alias for IndexWhere.
+func FindIndex[T any](s []T, pred func(T) bool) int {
+	return IndexWhere(s, pred)
+}
+
+// FindOpt is like Find but returns the result as an Optional, so that it
+// can be chained with optional.Map and optional.FlatMap.
+func FindOpt[T any](s []T, pred func(T) bool) optional.Optional[T] {
+	if v, ok := Find(s, pred); ok {
+		return optional.Some(v)
+	}
+	return optional.None[T]()
+}
@@ -0,0 +1,60 @@
+package slices
+
+import (
+	"fmt"
+	"testing"
+
+	"projektarbeit-go-generics/optional"
+)
+
+func TestFind(t *testing.T) {
+	s := []MyInt{1, 3, 4, 7, 8}
+	if v, ok := Find(s, isEven); !ok || v != 4 {
+		t.Errorf("Find(%v, isEven) = %v, %v, want 4, true", s, v, ok)
+	}
+	if v, ok := FindLast(s, isEven); !ok || v != 8 {
+		t.Errorf("FindLast(%v, isEven) = %v, %v, want 8, true", s, v, ok)
+	}
+	if i := FindIndex(s, isEven); i != 2 {
+		t.Errorf("FindIndex(%v, isEven) = %d, want 2", s, i)
+	}
+}
+
+func TestFindNone(t *testing.T) {
+	for _, s := range [][]MyInt{nil, {}, {1, 3, 5}} {
+		if v, ok := Find(s, isEven); ok || v != 0 {
+			t.Errorf("Find(%v, isEven) = %v, %v, want 0, false", s, v, ok)
+		}
+		if v, ok := FindLast(s, isEven); ok || v != 0 {
+			t.Errorf("FindLast(%v, isEven) = %v, %v, want 0, false", s, v, ok)
+		}
+		if i := FindIndex(s, isEven); i != -1 {
+			t.Errorf("FindIndex(%v, isEven) = %d, want -1", s, i)
+		}
+		if FindOpt(s, isEven).IsPresent() {
+			t.Errorf("FindOpt(%v, isEven) is present", s)
+		}
+	}
+}
+
+func TestFindOpt(t *testing.T) {
+	half := optional.Map(FindOpt([]MyInt{1, 3, 4, 7}, isEven), func(n MyInt) MyInt { return n / 2 })
+	if v, ok := half.Get(); !ok || v != 2 {
+		t.Errorf("half of the first even number = %v, %v, want 2, true", v, ok)
+	}
+}
+
+func ExampleFind() {
+	v, ok := Find([]MyInt{1, 3, 4, 7}, isEven)
+	fmt.Println(v, ok)
+	// Output: 4 true
+}
+
+func ExampleFindOpt() {
+	first := FindOpt([]MyInt{1, 3, 4, 7}, isEven)
+	fmt.Println(optional.Map(first, func(n MyInt) string { return fmt.Sprint("even: ", n) }).GetOrDefault("none"))
+	fmt.Println(FindOpt([]MyInt{1, 3}, isEven).GetOrDefault(-1))
+	// Output:
+	// even: 4
+	// -1
+}
@@ -0,0 +1,26 @@
+package slices
+
+// Flatten concatenates the inner slices of s into a new slice. Nil inner
+// slices are treated as empty. The result is allocated once.
+func Flatten[T any](s [][]T) []T {
+	total := 0
+	for _, inner := range s {
+		total += len(inner)
+	}
+	result := make([]T, 0, total)
+	for _, inner := range s {
+		result = append(result, inner...)
+	}
+	return result
+}
+
+// FlatMap applies f to each element of s and concatenates the results. f is
+// called exactly once per element. Its results are collected first, so the
+// total length is known and, like in Flatten, the result is allocated once.
+func FlatMap[T, U any](s []T, f func(T) []U) []U {
+	parts := make([][]U, len(s))
+	for i, v := range s {
+		parts[i] = f(v)
+	}
+	return Flatten(parts)
+}
@@ -0,0 +1,82 @@
+package slices
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFlatten(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		in   [][]int
+		want []int
+	}{
+		{"nil", nil, []int{}},
+		{"nil inner", [][]int{nil, {1, 2}, nil, {3}}, []int{1, 2, 3}},
+		{"empty inner", [][]int{{}, {}}, []int{}},
+		{"single", [][]int{{4, 5}}, []int{4, 5}},
+	} {
+		got := Flatten(tt.in)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: Flatten(%v) = %#v, want %#v", tt.name, tt.in, got, tt.want)
+		}
+		if cap(got) != len(got) {
+			t.Errorf("%s: cap(Flatten) = %d, want %d", tt.name, cap(got), len(got))
+		}
+	}
+}
+
+func TestFlattenCopies(t *testing.T) {
+	inner := []int{1, 2}
+	got := Flatten([][]int{inner})
+	got[0] = 100
+	if inner[0] != 1 {
+		t.Error("writing to the result of Flatten changed an inner slice")
+	}
+}
+
+func TestFlattenAllocatesOnce(t *testing.T) {
+	in := [][]int{{1, 2}, {3}, nil, {4, 5, 6}}
+	if allocs := testing.AllocsPerRun(100, func() { sink = Flatten(in) }); allocs != 1 {
+		t.Errorf("Flatten allocated %v times, want 1", allocs)
+	}
+}
+
+// TestFlatMapAllocations checks that besides the result FlatMap only
+// allocates the slice collecting the results of f.
+func TestFlatMapAllocations(t *testing.T) {
+	in := []int{1, 2, 3}
+	pair := []int{7, 8}
+	if allocs := testing.AllocsPerRun(100, func() { sink = FlatMap(in, func(int) []int { return pair }) }); allocs != 2 {
+		t.Errorf("FlatMap allocated %v times, want 2", allocs)
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	fields := func(s string) []string { return strings.Fields(s) }
+	for _, tt := range []struct {
+		name string
+		in   []string
+		want []string
+	}{
+		{"nil", nil, []string{}},
+		{"nil results", []string{"", "a b", "  ", "c"}, []string{"a", "b", "c"}},
+		{"single", []string{"x y"}, []string{"x", "y"}},
+	} {
+		if got := FlatMap(tt.in, fields); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: FlatMap(%q) = %#v, want %#v", tt.name, tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestFlatMapCallsOnce(t *testing.T) {
+	calls := 0
+	FlatMap([]int{1, 2, 3}, func(v int) []int {
+		calls++
+		return []int{v, v}
+	})
+	if calls != 3 {
+		t.Errorf("f called %d times, want 3", calls)
+	}
+}
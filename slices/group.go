@@ -0,0 +1,25 @@
+package slices
+
+// GroupBy splits s into groups of elements with the same key. Every group
+// keeps the order of its elements in s.
+func GroupBy[T any, K comparable](s []T, key func(T) K) map[K][]T {
+	groups := make(map[K][]T)
+	for _, v := range s {
+		k := key(v)
+		groups[k] = append(groups[k], v)
+	}
+	return groups
+}
+
+// Partition splits s into the elements for which pred returns true and
+// those for which it returns false, both in their original order.
+func Partition[T any](s []T, pred func(T) bool) (trueGroup, falseGroup []T) {
+	for _, v := range s {
+		if pred(v) {
+			trueGroup = append(trueGroup, v)
+		} else {
+			falseGroup = append(falseGroup, v)
+		}
+	}
+	return trueGroup, falseGroup
+}
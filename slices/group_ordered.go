@@ -0,0 +1,26 @@
+package slices
+
+import (
+	"cmp"
+
+	"projektarbeit-go-generics/collections"
+)
+
+// GroupByOrdered is like GroupBy but returns the groups in the order in
+// which their first element appears in s.
+func GroupByOrdered[T any, K cmp.Ordered](s []T, key func(T) K) *collections.OrderedMap[K, []T] {
+	groups := make(map[K][]T)
+	var order []K
+	for _, v := range s {
+		k := key(v)
+		if _, ok := groups[k]; !ok {
+			order = append(order, k)
+		}
+		groups[k] = append(groups[k], v)
+	}
+	m := collections.NewOrderedMap[K, []T]()
+	for _, k := range order {
+		m.Set(k, groups[k])
+	}
+	return m
+}
@@ -0,0 +1,46 @@
+package slices
+
+// IndexOf returns the index of the first occurrence of elem in s, or -1 if
+// s does not contain it.
+func IndexOf[T comparable](s []T, elem T) int {
+	for i, v := range s {
+		if v == elem {
+			return i
+		}
+	}
+	return -1
+}
+
+// LastIndexOf returns the index of the last occurrence of elem in s, or -1
+// if s does not contain it.
+func LastIndexOf[T comparable](s []T, elem T) int {
+	for i := len(s) - 1; i >= 0; i-- {
+		if s[i] == elem {
+			return i
+		}
+	}
+	return -1
+}
+
+// IndicesOf returns the indices of all occurrences of elem in s in
+// ascending order. It returns an empty, non-nil slice if there are none.
+func IndicesOf[T comparable](s []T, elem T) []int {
+	indices := []int{}
+	for i, v := range s {
+		if v == elem {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+// IndexWhere returns the index of the first element of s for which pred
+// returns true, or -1 if there is none.
+func IndexWhere[T any](s []T, pred func(T) bool) int {
+	for i, v := range s {
+		if pred(v) {
+			return i
+		}
+	}
+	return -1
+}
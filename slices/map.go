@@ -0,0 +1,38 @@
+// Package slices provides generic helper functions for working with slices.
+//
+// The module requires Go 1.23, but apart from GroupByOrdered and Shuffle,
+// which build on collections.OrderedMap and math/rand/v2, the package uses
+// no language feature or standard library package newer than Go 1.18. In
+// particular it does not depend on the standard library's slices and cmp
+// packages.
+package slices
+
+// Map returns a new slice containing the results of applying f to each
+// element of s. The result is allocated once with the length of s.
+func Map[T, U any](s []T, f func(T) U) []U {
+	if s == nil {
+		return nil
+	}
+	result := make([]U, len(s))
+	for i, v := range s {
+		result[i] = f(v)
+	}
+	return result
+}
+
+// MapErr is like Map but stops at the first error returned by f and
+// returns it together with a nil slice.
+func MapErr[T, U any](s []T, f func(T) (U, error)) ([]U, error) {
+	if s == nil {
+		return nil, nil
+	}
+	result := make([]U, len(s))
+	for i, v := range s {
+		u, err := f(v)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = u
+	}
+	return result, nil
+}
@@ -0,0 +1,90 @@
+package slices
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+// customInt mirrors the constraint family of the playground examples.
+type customInt[T int | int8 | int16 | int32 | int64] struct {
+	value T
+}
+
+func (c customInt[T]) String() string {
+	return fmt.Sprintf("customInt(%d)", c.value)
+}
+
+func TestMapNil(t *testing.T) {
+	if got := Map(nil, strconv.Itoa); got != nil {
+		t.Errorf("Map(nil) = %#v, want nil", got)
+	}
+}
+
+func TestMapEmpty(t *testing.T) {
+	got := Map([]int{}, strconv.Itoa)
+	if got == nil || len(got) != 0 {
+		t.Errorf("Map([]int{}) = %#v, want empty non-nil slice", got)
+	}
+}
+
+func TestMapCustomInt(t *testing.T) {
+	in := []customInt[int64]{{1}, {-2}, {3}}
+	got := Map(in, customInt[int64].String)
+	want := []string{"customInt(1)", "customInt(-2)", "customInt(3)"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Map = %v, want %v", got, want)
+	}
+
+	widened := Map([]customInt[int8]{{127}, {-128}}, func(c customInt[int8]) customInt[int32] {
+		return customInt[int32]{int32(c.value) * 2}
+	})
+	if want := []customInt[int32]{{254}, {-256}}; !reflect.DeepEqual(widened, want) {
+		t.Errorf("Map = %v, want %v", widened, want)
+	}
+}
+
+// sink keeps results on the heap in allocation tests.
+var sink []int
+
+func TestMapAllocatesOnce(t *testing.T) {
+	in := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	double := func(v int) int { return 2 * v }
+	if allocs := testing.AllocsPerRun(100, func() { sink = Map(in, double) }); allocs != 1 {
+		t.Errorf("Map allocated %v times, want 1", allocs)
+	}
+}
+
+func TestMapErr(t *testing.T) {
+	got, err := MapErr([]string{"1", "2", "3"}, strconv.Atoi)
+	if err != nil || !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("MapErr = %v, %v, want [1 2 3], nil", got, err)
+	}
+
+	if got, err := MapErr(nil, strconv.Atoi); got != nil || err != nil {
+		t.Errorf("MapErr(nil) = %#v, %v, want nil, nil", got, err)
+	}
+	if got, err := MapErr([]string{}, strconv.Atoi); got == nil || len(got) != 0 || err != nil {
+		t.Errorf("MapErr([]string{}) = %#v, %v, want empty non-nil slice, nil", got, err)
+	}
+}
+
+func TestMapErrShortCircuits(t *testing.T) {
+	errOdd := errors.New("odd")
+	var calls int
+	got, err := MapErr([]customInt[int16]{{2}, {3}, {4}}, func(c customInt[int16]) (int16, error) {
+		calls++
+		if c.value%2 != 0 {
+			return 0, errOdd
+		}
+		return c.value / 2, nil
+	})
+	if got != nil || err != errOdd {
+		t.Errorf("MapErr = %v, %v, want nil, %v", got, err, errOdd)
+	}
+	if calls != 2 {
+		t.Errorf("f called %d times, want 2", calls)
+	}
+}
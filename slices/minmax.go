@@ -0,0 +1,79 @@
+package slices
+
+// Ordered is the set of types that support the < operator. It matches
+// cmp.Ordered, which is only available from Go 1.21 on.
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 | ~string
+}
+
+// less orders NaNs before all other values, like cmp.Less.
+func less[T Ordered](a, b T) bool {
+	return (a != a && b == b) || a < b
+}
+
+// compare returns -1, 0 or +1 like cmp.Compare.
+func compare[T Ordered](a, b T) int {
+	switch {
+	case less(a, b):
+		return -1
+	case less(b, a):
+		return +1
+	}
+	return 0
+}
+
+// Min returns the smallest element of s, or false if s is empty.
+func Min[T Ordered](s []T) (T, bool) {
+	return MinBy(s, func(v T) T { return v })
+}
+
+// Max returns the largest element of s, or false if s is empty.
+func Max[T Ordered](s []T) (T, bool) {
+	return MaxBy(s, func(v T) T { return v })
+}
+
+// MinBy returns the first element of s with the smallest key, or false if s
+// is empty.
+func MinBy[T any, K Ordered](s []T, key func(T) K) (T, bool) {
+	return extremeBy(s, key, less[K])
+}
+
+// MaxBy returns the first element of s with the largest key, or false if s
+// is empty.
+func MaxBy[T any, K Ordered](s []T, key func(T) K) (T, bool) {
+	return extremeBy(s, key, func(a, b K) bool { return less(b, a) })
+}
+
+func extremeBy[T any, K Ordered](s []T, key func(T) K, better func(K, K) bool) (T, bool) {
+	if len(s) == 0 {
+		var zero T
+		return zero, false
+	}
+	best, bestKey := s[0], key(s[0])
+	for _, v := range s[1:] {
+		if k := key(v); better(k, bestKey) {
+			best, bestKey = v, k
+		}
+	}
+	return best, true
+}
+
+// MinMax returns the smallest and largest element of s in a single pass, or
+// false if s is empty.
+func MinMax[T Ordered](s []T) (minimum, maximum T, ok bool) {
+	if len(s) == 0 {
+		return minimum, maximum, false
+	}
+	minimum, maximum = s[0], s[0]
+	for _, v := range s[1:] {
+		if less(v, minimum) {
+			minimum = v
+		}
+		if less(maximum, v) {
+			maximum = v
+		}
+	}
+	return minimum, maximum, true
+}
@@ -0,0 +1,29 @@
+package slices
+
+// All reports whether pred holds for every element of s. It is true for an
+// empty slice.
+func All[T any](s []T, pred func(T) bool) bool {
+	for _, v := range s {
+		if !pred(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Any reports whether pred holds for at least one element of s. It is false
+// for an empty slice.
+func Any[T any](s []T, pred func(T) bool) bool {
+	for _, v := range s {
+		if pred(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// None reports whether pred holds for no element of s. It is true for an
+// empty slice.
+func None[T any](s []T, pred func(T) bool) bool {
+	return !Any(s, pred)
+}
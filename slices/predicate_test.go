@@ -0,0 +1,73 @@
+package slices
+
+import "testing"
+
+// MyInt and isEven mirror the declarations in example1.go.e.
+type MyInt int
+
+func isEven(n MyInt) bool { return n%2 == 0 }
+
+func TestAll(t *testing.T) {
+	if !All([]MyInt{2, 4, 6}, isEven) {
+		t.Error("All([2 4 6], isEven) = false, want true")
+	}
+	if All([]MyInt{2, 3, 4}, isEven) {
+		t.Error("All([2 3 4], isEven) = true, want false")
+	}
+}
+
+func TestAny(t *testing.T) {
+	if !Any([]MyInt{1, 3, 4}, isEven) {
+		t.Error("Any([1 3 4], isEven) = false, want true")
+	}
+	if Any([]MyInt{1, 3, 5}, isEven) {
+		t.Error("Any([1 3 5], isEven) = true, want false")
+	}
+}
+
+func TestNone(t *testing.T) {
+	if !None([]MyInt{1, 3, 5}, isEven) {
+		t.Error("None([1 3 5], isEven) = false, want true")
+	}
+	if None([]MyInt{1, 2}, isEven) {
+		t.Error("None([1 2], isEven) = true, want false")
+	}
+}
+
+func TestPredicatesEmpty(t *testing.T) {
+	for _, s := range [][]MyInt{nil, {}} {
+		if !All(s, isEven) {
+			t.Errorf("All(%#v) = false, want true", s)
+		}
+		if Any(s, isEven) {
+			t.Errorf("Any(%#v) = true, want false", s)
+		}
+		if !None(s, isEven) {
+			t.Errorf("None(%#v) = false, want true", s)
+		}
+	}
+}
+
+func TestPredicatesShortCircuit(t *testing.T) {
+	calls := 0
+	counting := func(n MyInt) bool {
+		calls++
+		return isEven(n)
+	}
+	for _, tt := range []struct {
+		name string
+		f    func([]MyInt, func(MyInt) bool) bool
+		s    []MyInt
+		want int
+	}{
+		{"All", All[MyInt], []MyInt{2, 3, 4, 6}, 2},
+		{"Any", Any[MyInt], []MyInt{1, 2, 3, 5}, 2},
+		{"None", None[MyInt], []MyInt{1, 2, 3, 5}, 2},
+	} {
+		calls = 0
+		tt.f(tt.s, counting)
+		if calls != tt.want {
+			t.Errorf("%s called pred %d times, want %d", tt.name, calls, tt.want)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package slices
+
+// Reduce folds s from left to right, starting with initial and combining the
+// accumulator with each element using f. For an empty slice it returns initial.
+func Reduce[T, Acc any](s []T, initial Acc, f func(Acc, T) Acc) Acc {
+	acc := initial
+	for _, v := range s {
+		acc = f(acc, v)
+	}
+	return acc
+}
+
+// ReduceRight is like Reduce but processes the elements of s from right to
+// left. For an empty slice it returns initial.
+func ReduceRight[T, Acc any](s []T, initial Acc, f func(Acc, T) Acc) Acc {
+	acc := initial
+	for i := len(s) - 1; i >= 0; i-- {
+		acc = f(acc, s[i])
+	}
+	return acc
+}
+
+// FoldFirst folds s from left to right using the first element as the seed.
+// It returns false if s is empty.
+func FoldFirst[T any](s []T, f func(T, T) T) (T, bool) {
+	if len(s) == 0 {
+		var zero T
+		return zero, false
+	}
+	return Reduce(s[1:], s[0], f), true
+}
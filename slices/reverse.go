@@ -0,0 +1,31 @@
+package slices
+
+// Reverse reverses the elements of s in place.
+func Reverse[T any](s []T) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+// Reversed returns a reversed copy of s without modifying it.
+func Reversed[T any](s []T) []T {
+	if s == nil {
+		return nil
+	}
+	result := make([]T, len(s))
+	for i, v := range s {
+		result[len(s)-1-i] = v
+	}
+	return result
+}
+
+// IsPalindrome reports whether s reads the same forwards and backwards. It
+// does not allocate.
+func IsPalindrome[T comparable](s []T) bool {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		if s[i] != s[j] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,47 @@
+package slices
+
+// Intersect returns the elements of a that also occur in b, without
+// duplicates and in the order of their first occurrence in a. It runs in
+// O(len(a)+len(b)).
+func Intersect[T comparable](a, b []T) []T {
+	inB := toSet(b)
+	var result []T
+	for _, v := range a {
+		if _, ok := inB[v]; ok {
+			result = append(result, v)
+			delete(inB, v)
+		}
+	}
+	return result
+}
+
+// SliceUnion returns the elements occurring in a or b, without duplicates,
+// first those from a and then the remaining ones from b, each in order of
+// first occurrence.
+func SliceUnion[T comparable](a, b []T) []T {
+	seen := make(map[T]struct{}, len(a)+len(b))
+	var result []T
+	for _, s := range [][]T{a, b} {
+		for _, v := range s {
+			if _, ok := seen[v]; !ok {
+				seen[v] = struct{}{}
+				result = append(result, v)
+			}
+		}
+	}
+	return result
+}
+
+// SliceDifference returns the elements of a that do not occur in b,
+// without duplicates and in the order of their first occurrence in a.
+func SliceDifference[T comparable](a, b []T) []T {
+	exclude := toSet(b)
+	var result []T
+	for _, v := range a {
+		if _, ok := exclude[v]; !ok {
+			result = append(result, v)
+			exclude[v] = struct{}{}
+		}
+	}
+	return result
+}
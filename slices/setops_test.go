@@ -0,0 +1,70 @@
+package slices
+
+import (
+	"reflect"
+	"testing"
+)
+
+func c32(values ...int32) []customInt[int32] {
+	s := make([]customInt[int32], len(values))
+	for i, v := range values {
+		s[i] = customInt[int32]{v}
+	}
+	return s
+}
+
+func TestSetOps(t *testing.T) {
+	a := c32(3, 1, 2, 1, 4)
+	b := c32(4, 5, 1, 5)
+	tests := []struct {
+		name string
+		got  []customInt[int32]
+		want []customInt[int32]
+	}{
+		{"Intersect", Intersect(a, b), c32(1, 4)},
+		{"SliceUnion", SliceUnion(a, b), c32(3, 1, 2, 4, 5)},
+		{"SliceDifference", SliceDifference(a, b), c32(3, 2)},
+		{"SliceDifference reversed", SliceDifference(b, a), c32(5)},
+	}
+	for _, tt := range tests {
+		if !reflect.DeepEqual(tt.got, tt.want) {
+			t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestSetOpsEmpty(t *testing.T) {
+	a := c32(1, 2, 2)
+	for _, empty := range [][]customInt[int32]{nil, {}} {
+		if got := Intersect(a, empty); len(got) != 0 {
+			t.Errorf("Intersect(a, %#v) = %v, want empty", empty, got)
+		}
+		if got := Intersect(empty, a); len(got) != 0 {
+			t.Errorf("Intersect(%#v, a) = %v, want empty", empty, got)
+		}
+		if got, want := SliceUnion(empty, a), c32(1, 2); !reflect.DeepEqual(got, want) {
+			t.Errorf("SliceUnion(%#v, a) = %v, want %v", empty, got, want)
+		}
+		if got, want := SliceDifference(a, empty), c32(1, 2); !reflect.DeepEqual(got, want) {
+			t.Errorf("SliceDifference(a, %#v) = %v, want %v", empty, got, want)
+		}
+		if got := SliceDifference(empty, a); len(got) != 0 {
+			t.Errorf("SliceDifference(%#v, a) = %v, want empty", empty, got)
+		}
+		if got := SliceUnion(empty, empty); len(got) != 0 {
+			t.Errorf("SliceUnion of empty slices = %v, want empty", got)
+		}
+	}
+}
+
+// TestSetOpsDoNotModify checks that the inputs are left unchanged, in
+// particular that Intersect does not consume b.
+func TestSetOpsDoNotModify(t *testing.T) {
+	a, b := c32(1, 2, 3), c32(3, 1)
+	Intersect(a, b)
+	SliceUnion(a, b)
+	SliceDifference(a, b)
+	if !reflect.DeepEqual(a, c32(1, 2, 3)) || !reflect.DeepEqual(b, c32(3, 1)) {
+		t.Errorf("inputs changed to %v and %v", a, b)
+	}
+}
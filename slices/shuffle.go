@@ -0,0 +1,24 @@
+package slices
+
+import "math/rand/v2"
+
+// Shuffle permutes s in place with the Fisher-Yates algorithm, drawing
+// random numbers from r. The same seeded source always yields the same
+// permutation.
+func Shuffle[T any](s []T, r rand.Source) {
+	rng := rand.New(r)
+	for i := len(s) - 1; i > 0; i-- {
+		j := rng.IntN(i + 1)
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+// ShuffleNew returns a shuffled copy of s without modifying it.
+func ShuffleNew[T any](s []T, r rand.Source) []T {
+	if s == nil {
+		return nil
+	}
+	result := append([]T(nil), s...)
+	Shuffle(result, r)
+	return result
+}
@@ -0,0 +1,67 @@
+package slices
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"testing"
+)
+
+func customInts(n int) []customInt[int] {
+	s := make([]customInt[int], n)
+	for i := range s {
+		s[i] = customInt[int]{i}
+	}
+	return s
+}
+
+func byValue(c customInt[int]) int { return c.value }
+
+func TestShuffleIsPermutation(t *testing.T) {
+	s := customInts(50)
+	Shuffle(s, rand.NewPCG(1, 2))
+	if reflect.DeepEqual(s, customInts(50)) {
+		t.Error("Shuffle left 50 elements in order")
+	}
+	if got := SortBy(s, byValue); !reflect.DeepEqual(got, customInts(50)) {
+		t.Errorf("sorted shuffle = %v, want %v", got, customInts(50))
+	}
+}
+
+func TestShuffleSeedReproducible(t *testing.T) {
+	a, b := customInts(20), customInts(20)
+	Shuffle(a, rand.NewPCG(7, 8))
+	Shuffle(b, rand.NewPCG(7, 8))
+	if !reflect.DeepEqual(a, b) {
+		t.Errorf("Shuffle with the same seed = %v and %v", a, b)
+	}
+	c := customInts(20)
+	Shuffle(c, rand.NewPCG(9, 10))
+	if reflect.DeepEqual(a, c) {
+		t.Errorf("Shuffle with different seeds both gave %v", a)
+	}
+}
+
+func TestShuffleNew(t *testing.T) {
+	s := customInts(20)
+	got := ShuffleNew(s, rand.NewPCG(1, 2))
+	if !reflect.DeepEqual(s, customInts(20)) {
+		t.Errorf("ShuffleNew modified its input: %v", s)
+	}
+	want := customInts(20)
+	Shuffle(want, rand.NewPCG(1, 2))
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ShuffleNew = %v, want %v", got, want)
+	}
+	if ShuffleNew[int](nil, rand.NewPCG(1, 2)) != nil {
+		t.Error("ShuffleNew(nil) is not nil")
+	}
+}
+
+func TestShuffleShort(t *testing.T) {
+	Shuffle([]customInt[int](nil), rand.NewPCG(1, 2))
+	one := []customInt[int]{{5}}
+	Shuffle(one, rand.NewPCG(1, 2))
+	if one[0].value != 5 {
+		t.Errorf("Shuffle of one element = %v", one)
+	}
+}
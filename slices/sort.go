@@ -0,0 +1,46 @@
+package slices
+
+import "sort"
+
+// SortBy sorts s in place by the keys returned by key and returns s. key is
+// called once per element, not once per comparison.
+//
+// SortBy does not delegate to the standard library's slices.SortFunc. That
+// would need Go 1.21 and call key twice per comparison, O(n log n) times in
+// total. Instead the keys are computed up front and sorted along with s
+// through sort.Sort, which costs one extra slice of len(s) keys and an
+// interface call per comparison. For cheap keys slices.SortFunc with
+// cmp.Compare is faster; for expensive ones SortBy is.
+func SortBy[T any, K Ordered](s []T, key func(T) K) []T {
+	sort.Sort(newKeySorter(s, key))
+	return s
+}
+
+// SortStableBy is like SortBy but keeps the original order of elements with
+// equal keys.
+func SortStableBy[T any, K Ordered](s []T, key func(T) K) []T {
+	sort.Stable(newKeySorter(s, key))
+	return s
+}
+
+// keySorter sorts s by the precomputed keys, swapping both in step.
+type keySorter[T any, K Ordered] struct {
+	s    []T
+	keys []K
+}
+
+func newKeySorter[T any, K Ordered](s []T, key func(T) K) *keySorter[T, K] {
+	keys := make([]K, len(s))
+	for i, v := range s {
+		keys[i] = key(v)
+	}
+	return &keySorter[T, K]{s: s, keys: keys}
+}
+
+func (ks *keySorter[T, K]) Len() int           { return len(ks.s) }
+func (ks *keySorter[T, K]) Less(i, j int) bool { return less(ks.keys[i], ks.keys[j]) }
+
+func (ks *keySorter[T, K]) Swap(i, j int) {
+	ks.s[i], ks.s[j] = ks.s[j], ks.s[i]
+	ks.keys[i], ks.keys[j] = ks.keys[j], ks.keys[i]
+}
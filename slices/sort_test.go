@@ -0,0 +1,78 @@
+package slices
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+type person struct {
+	Name string
+	Age  int
+}
+
+func TestSortBy(t *testing.T) {
+	people := []person{{"Carol", 35}, {"alice", 30}, {"Bob", 25}}
+	got := SortBy(people, func(p person) string { return p.Name })
+	want := []person{{"Bob", 25}, {"Carol", 35}, {"alice", 30}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SortBy(Name) = %v, want %v", got, want)
+	}
+	if &got[0] != &people[0] {
+		t.Error("SortBy did not return its input")
+	}
+	SortBy(people, func(p person) int { return p.Age })
+	if people[0].Name != "Bob" || people[2].Name != "Carol" {
+		t.Errorf("SortBy(Age) = %v", people)
+	}
+}
+
+func TestSortStableBy(t *testing.T) {
+	people := []person{{"a", 2}, {"b", 1}, {"c", 2}, {"d", 1}, {"e", 2}}
+	got := SortStableBy(people, func(p person) int { return p.Age })
+	want := []person{{"b", 1}, {"d", 1}, {"a", 2}, {"c", 2}, {"e", 2}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SortStableBy = %v, want %v", got, want)
+	}
+}
+
+func TestSortByFloatNaN(t *testing.T) {
+	// Like the standard library, NaNs sort before all other values.
+	got := SortBy([]float64{3, math.NaN(), 1}, func(f float64) float64 { return f })
+	if !math.IsNaN(got[0]) || got[1] != 1 || got[2] != 3 {
+		t.Errorf("SortBy = %v, want [NaN 1 3]", got)
+	}
+}
+
+// benchmarkPeople returns n people with names of at most 32 bytes.
+func benchmarkPeople(n int) []person {
+	r := rand.New(rand.NewSource(1))
+	people := make([]person, n)
+	for i := range people {
+		people[i] = person{Name: fmt.Sprintf("person-%024d", r.Int63())}
+	}
+	return people
+}
+
+func BenchmarkSortBy(b *testing.B) {
+	in := benchmarkPeople(1000)
+	s := make([]person, len(in))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(s, in)
+		SortBy(s, func(p person) string { return p.Name })
+	}
+}
+
+func BenchmarkSortComparator(b *testing.B) {
+	in := benchmarkPeople(1000)
+	s := make([]person, len(in))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(s, in)
+		sort.Slice(s, func(i, j int) bool { return s[i].Name < s[j].Name })
+	}
+}
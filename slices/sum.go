@@ -0,0 +1,57 @@
+package slices
+
+import (
+	"errors"
+	"math"
+)
+
+// Number is the set of signed integer and floating-point types accepted by
+// Sum and Product.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~float32 | ~float64
+}
+
+// ErrOverflow is returned by SumErr when the sum does not fit into its type.
+var ErrOverflow = errors.New("slices: sum overflows")
+
+// Sum returns the sum of the elements of s, or zero for an empty slice.
+func Sum[T Number](s []T) T {
+	var sum T
+	for _, v := range s {
+		sum += v
+	}
+	return sum
+}
+
+// Product returns the product of the elements of s, or one for an empty
+// slice.
+func Product[T Number](s []T) T {
+	product := T(1)
+	for _, v := range s {
+		product *= v
+	}
+	return product
+}
+
+// SumErr is like Sum but returns ErrOverflow instead of silently wrapping
+// around when an intermediate sum exceeds the range of T. For floating-point
+// types an overflow is a sum that becomes infinite.
+func SumErr[T Number](s []T) (T, error) {
+	half := T(1)
+	half /= 2
+	isFloat := half != 0
+
+	var sum T
+	for _, v := range s {
+		next := sum + v
+		if isFloat {
+			if math.IsInf(float64(next), 0) && !math.IsInf(float64(v), 0) {
+				return sum, ErrOverflow
+			}
+		} else if (v > 0 && next < sum) || (v < 0 && next > sum) {
+			return sum, ErrOverflow
+		}
+		sum = next
+	}
+	return sum, nil
+}
@@ -0,0 +1,62 @@
+package slices
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSum(t *testing.T) {
+	if got := Sum([]MyInt{1, 2, 3}); got != 6 {
+		t.Errorf("Sum([1 2 3]) = %d, want 6", got)
+	}
+	if got := Sum([]float64{0.5, 0.25}); got != 0.75 {
+		t.Errorf("Sum([0.5 0.25]) = %v, want 0.75", got)
+	}
+	if got := Sum([]int8(nil)); got != 0 {
+		t.Errorf("Sum(nil) = %d, want 0", got)
+	}
+}
+
+func TestProduct(t *testing.T) {
+	if got := Product([]MyInt{2, 3, 4}); got != 24 {
+		t.Errorf("Product([2 3 4]) = %d, want 24", got)
+	}
+	if got := Product([]float32{}); got != 1 {
+		t.Errorf("Product([]) = %v, want 1", got)
+	}
+}
+
+func TestSumErr(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		in   []int8
+		want int8
+		err  error
+	}{
+		{"fits", []int8{100, 27}, 127, nil},
+		{"positive overflow", []int8{100, 28}, 100, ErrOverflow},
+		{"negative overflow", []int8{-100, -29}, -100, ErrOverflow},
+		{"recovers", []int8{100, -100, 100}, 100, nil},
+		{"empty", nil, 0, nil},
+	} {
+		got, err := SumErr(tt.in)
+		if got != tt.want || err != tt.err {
+			t.Errorf("%s: SumErr(%v) = %d, %v, want %d, %v", tt.name, tt.in, got, err, tt.want, tt.err)
+		}
+	}
+	if _, err := SumErr([]int{math.MaxInt, 1}); err != ErrOverflow {
+		t.Errorf("SumErr([MaxInt 1]) error = %v, want %v", err, ErrOverflow)
+	}
+}
+
+func TestSumErrFloat(t *testing.T) {
+	if _, err := SumErr([]float32{math.MaxFloat32, math.MaxFloat32}); err != ErrOverflow {
+		t.Errorf("SumErr([MaxFloat32 MaxFloat32]) error = %v, want %v", err, ErrOverflow)
+	}
+	if got, err := SumErr([]float64{1, math.Inf(1)}); !math.IsInf(got, 1) || err != nil {
+		t.Errorf("SumErr([1 +Inf]) = %v, %v, want +Inf, nil", got, err)
+	}
+	if got, err := SumErr([]float64{0.5, 1.5}); got != 2 || err != nil {
+		t.Errorf("SumErr([0.5 1.5]) = %v, %v, want 2, nil", got, err)
+	}
+}
@@ -0,0 +1,46 @@
+package slices
+
+// Take returns the first n elements of s, or all of s if it is shorter. The
+// result shares the backing array of s. A negative n is treated as zero.
+func Take[T any](s []T, n int) []T {
+	return s[:clampIndex(n, len(s))]
+}
+
+// Drop returns the elements of s from index n onward. The result shares the
+// backing array of s. A negative n is treated as zero.
+func Drop[T any](s []T, n int) []T {
+	return s[clampIndex(n, len(s)):]
+}
+
+// TakeWhile returns a copy of the longest prefix of s whose elements all
+// satisfy pred.
+func TakeWhile[T any](s []T, pred func(T) bool) []T {
+	n := prefixLen(s, pred)
+	return append([]T(nil), s[:n]...)
+}
+
+// DropWhile returns a copy of s without the longest prefix whose elements
+// all satisfy pred.
+func DropWhile[T any](s []T, pred func(T) bool) []T {
+	n := prefixLen(s, pred)
+	return append([]T(nil), s[n:]...)
+}
+
+func clampIndex(n, length int) int {
+	switch {
+	case n < 0:
+		return 0
+	case n > length:
+		return length
+	}
+	return n
+}
+
+func prefixLen[T any](s []T, pred func(T) bool) int {
+	for i, v := range s {
+		if !pred(v) {
+			return i
+		}
+	}
+	return len(s)
+}
@@ -0,0 +1,30 @@
+package slices
+
+// Unique returns a new slice holding the first occurrence of every element
+// of s, in the order of s.
+func Unique[T comparable](s []T) []T {
+	return UniqueBy(s, func(v T) T { return v })
+}
+
+// UniqueStable is an alias of Unique that makes its guarantee explicit: the
+// relative order of the kept elements is the order in s.
+func UniqueStable[T comparable](s []T) []T {
+	return Unique(s)
+}
+
+// UniqueBy returns a new slice holding the first element of s for every
+// distinct key, in the order of s. Like any map key, a key holding an
+// interface value panics if its dynamic type is not comparable.
+func UniqueBy[T any, K comparable](s []T, key func(T) K) []T {
+	seen := make(map[K]struct{}, len(s))
+	var result []T
+	for _, v := range s {
+		k := key(v)
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		result = append(result, v)
+	}
+	return result
+}
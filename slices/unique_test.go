@@ -0,0 +1,74 @@
+package slices
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestUnique(t *testing.T) {
+	got := Unique([]int{3, 1, 3, 2, 1, 3})
+	if want := []int{3, 1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Unique = %v, want %v", got, want)
+	}
+	if got := UniqueStable([]string{"b", "a", "b"}); !reflect.DeepEqual(got, []string{"b", "a"}) {
+		t.Errorf("UniqueStable = %v, want [b a]", got)
+	}
+	if got := Unique([]int(nil)); len(got) != 0 {
+		t.Errorf("Unique(nil) = %v, want empty", got)
+	}
+}
+
+func TestUniqueSharesNoMemory(t *testing.T) {
+	in := []int{1, 2, 3}
+	got := Unique(in)
+	got[0] = 100
+	if in[0] != 1 {
+		t.Error("writing to the result of Unique changed the input")
+	}
+}
+
+func TestUniqueCustomInt(t *testing.T) {
+	in := []customInt[int]{{1}, {2}, {1}, {3}, {2}}
+	if got, want := Unique(in), []customInt[int]{{1}, {2}, {3}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Unique = %v, want %v", got, want)
+	}
+}
+
+// A and B stand in for the embedded types a.A and b.B of the struct C
+// from the go/types documentation.
+type (
+	A struct{ name string }
+	B struct{ id int }
+	C struct {
+		A
+		B
+	}
+)
+
+func TestUniqueEmbeddedStructs(t *testing.T) {
+	in := []C{{A{"x"}, B{1}}, {A{"x"}, B{2}}, {A{"x"}, B{1}}, {A{"y"}, B{1}}}
+	if got, want := Unique(in), []C{in[0], in[1], in[3]}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Unique = %v, want %v", got, want)
+	}
+	byA := UniqueBy(in, func(c C) A { return c.A })
+	if want := []C{in[0], in[3]}; !reflect.DeepEqual(byA, want) {
+		t.Errorf("UniqueBy(A) = %v, want %v", byA, want)
+	}
+}
+
+func TestUniqueBy(t *testing.T) {
+	got := UniqueBy([]string{"Go", "go", "Rust", "GO", "rust"}, strings.ToLower)
+	if want := []string{"Go", "Rust"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("UniqueBy = %v, want %v", got, want)
+	}
+}
+
+func TestUniqueByIncomparableKey(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("UniqueBy with a slice held in an interface key did not panic")
+		}
+	}()
+	UniqueBy([][]int{{1}}, func(s []int) interface{} { return s })
+}
@@ -0,0 +1,42 @@
+package slices
+
+import "projektarbeit-go-generics/tuple"
+
+// Zip combines a and b element-wise into pairs. The result has the length of
+// the shorter input.
+func Zip[A, B any](a []A, b []B) []tuple.Pair[A, B] {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	result := make([]tuple.Pair[A, B], n)
+	for i := 0; i < n; i++ {
+		result[i] = tuple.NewPair(a[i], b[i])
+	}
+	return result
+}
+
+// Unzip splits pairs into two slices holding the first and second values.
+func Unzip[A, B any](pairs []tuple.Pair[A, B]) ([]A, []B) {
+	as := make([]A, len(pairs))
+	bs := make([]B, len(pairs))
+	for i, p := range pairs {
+		as[i] = p.First
+		bs[i] = p.Second
+	}
+	return as, bs
+}
+
+// ZipWith combines a and b element-wise using f without building
+// intermediate pairs. The result has the length of the shorter input.
+func ZipWith[A, B, C any](a []A, b []B, f func(A, B) C) []C {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	result := make([]C, n)
+	for i := 0; i < n; i++ {
+		result[i] = f(a[i], b[i])
+	}
+	return result
+}
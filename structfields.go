@@ -0,0 +1,63 @@
+package main
+
+import (
+	"fmt"
+	"go/token"
+	"go/types"
+	"strings"
+)
+
+// WalkStructFields calls visit for every field of the struct underlying t,
+// descending into embedded structs. index is the path usable with
+// reflect.Type.FieldByIndex and promoted reports whether the field is only
+// reachable through embedding. An embedded field is visited before the
+// fields it promotes. Pointers to structs are followed, and a struct type
+// that embeds itself (directly or indirectly) is not expanded again.
+func WalkStructFields(t types.Type, visit func(field *types.Var, index []int, promoted bool)) {
+	walkStructFields(t, nil, make(map[types.Type]bool), visit)
+}
+
+func walkStructFields(t types.Type, prefix []int, seen map[types.Type]bool, visit func(*types.Var, []int, bool)) {
+	if ptr, ok := types.Unalias(t).(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	st, ok := t.Underlying().(*types.Struct)
+	if !ok || seen[t] {
+		return
+	}
+	seen[t] = true
+	defer delete(seen, t)
+
+	for i := range st.NumFields() {
+		field := st.Field(i)
+		index := append(prefix[:len(prefix):len(prefix)], i)
+		visit(field, index, len(prefix) > 0)
+		if field.Embedded() {
+			walkStructFields(field.Type(), index, seen, visit)
+		}
+	}
+}
+
+func structFields(c checkedPackage, pos token.Pos, scope *types.Scope, args string) string {
+	t, ok := lookupType(c.pkg, scope, pos, args)
+	if !ok {
+		return fmt.Sprintf("\t<type %s not found>\n", args)
+	}
+	if _, ok := t.Underlying().(*types.Struct); !ok {
+		return fmt.Sprintf("\t<%s is not a struct>\n", args)
+	}
+
+	buff := &strings.Builder{}
+	WalkStructFields(t, func(field *types.Var, index []int, promoted bool) {
+		kind := "declared"
+		switch {
+		case promoted:
+			kind = "promoted"
+		case field.Embedded():
+			kind = "embedded"
+		}
+		fmt.Fprintf(buff, "\t%s%v %s %s (%s)\n", strings.Repeat("\t", len(index)-1), index,
+			field.Name(), NormalizeTypeName(field.Type(), c.pkg), kind)
+	})
+	return buff.String()
+}
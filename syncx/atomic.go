@@ -0,0 +1,84 @@
+package syncx
+
+import "sync/atomic"
+
+// Atomic holds a value of type T that is loaded and stored atomically. It
+// is built on atomic.Pointer and keeps a private copy of every stored value.
+// The zero value holds the zero value of T.
+type Atomic[T any] struct {
+	p atomic.Pointer[T]
+}
+
+func load[T any](p *T) T {
+	if p == nil {
+		var zero T
+		return zero
+	}
+	return *p
+}
+
+// Load returns the current value.
+func (a *Atomic[T]) Load() T {
+	return load(a.p.Load())
+}
+
+// Store sets the current value to v.
+func (a *Atomic[T]) Store(v T) {
+	a.p.Store(&v)
+}
+
+// Swap stores v and returns the previous value.
+func (a *Atomic[T]) Swap(v T) T {
+	return load(a.p.Swap(&v))
+}
+
+// CompareAndSwap stores new if the current value equals old. Values are
+// compared with ==, so reference types like pointers, maps and channels are
+// compared by identity. It panics if T is not comparable at run time.
+func (a *Atomic[T]) CompareAndSwap(old, new T) bool {
+	for {
+		cur := a.p.Load()
+		if any(load(cur)) != any(old) {
+			return false
+		}
+		if a.p.CompareAndSwap(cur, &new) {
+			return true
+		}
+	}
+}
+
+// AtomicValue is a typed wrapper around atomic.Value. Like atomic.Value it
+// panics when storing a nil interface value or values of differing concrete
+// types. The zero value holds the zero value of T.
+type AtomicValue[T any] struct {
+	v atomic.Value
+}
+
+func loadValue[T any](v any) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return v.(T)
+}
+
+// Load returns the current value.
+func (a *AtomicValue[T]) Load() T {
+	return loadValue[T](a.v.Load())
+}
+
+// Store sets the current value to v.
+func (a *AtomicValue[T]) Store(v T) {
+	a.v.Store(v)
+}
+
+// Swap stores v and returns the previous value.
+func (a *AtomicValue[T]) Swap(v T) T {
+	return loadValue[T](a.v.Swap(v))
+}
+
+// CompareAndSwap stores new if the current value equals old. It panics if T
+// is not comparable at run time.
+func (a *AtomicValue[T]) CompareAndSwap(old, new T) bool {
+	return a.v.CompareAndSwap(old, new)
+}
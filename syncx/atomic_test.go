@@ -0,0 +1,92 @@
+package syncx
+
+import (
+	"sync"
+	"testing"
+)
+
+// casCounter is implemented by Atomic[int] and AtomicValue[int].
+type casCounter interface {
+	Load() int
+	CompareAndSwap(old, new int) bool
+}
+
+// incrementConcurrently increments c from many goroutines with
+// CompareAndSwap loops. An increment is lost if a swap succeeds against a
+// value that has changed in the meantime.
+func incrementConcurrently(c casCounter, goroutines, increments int) {
+	var wg sync.WaitGroup
+	for range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range increments {
+				for {
+					v := c.Load()
+					if c.CompareAndSwap(v, v+1) {
+						break
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestAtomicCompareAndSwapContention(t *testing.T) {
+	var a Atomic[int]
+	incrementConcurrently(&a, 20, 500)
+	if got := a.Load(); got != 20*500 {
+		t.Errorf("Load() = %d, want %d", got, 20*500)
+	}
+}
+
+func TestAtomicValueCompareAndSwapContention(t *testing.T) {
+	var a AtomicValue[int]
+	a.Store(0) // atomic.Value only swaps once a value is stored
+	incrementConcurrently(&a, 20, 500)
+	if got := a.Load(); got != 20*500 {
+		t.Errorf("Load() = %d, want %d", got, 20*500)
+	}
+}
+
+func TestAtomic(t *testing.T) {
+	type point struct{ x, y int }
+	var a Atomic[point]
+	if got := a.Load(); got != (point{}) {
+		t.Errorf("zero Load() = %v, want %v", got, point{})
+	}
+	if !a.CompareAndSwap(point{}, point{1, 2}) {
+		t.Error("CompareAndSwap on the zero value failed")
+	}
+	if a.CompareAndSwap(point{}, point{3, 4}) {
+		t.Error("CompareAndSwap with a stale old value succeeded")
+	}
+	if old := a.Swap(point{5, 6}); old != (point{1, 2}) {
+		t.Errorf("Swap() = %v, want %v", old, point{1, 2})
+	}
+
+	// Storing copies the value, so later changes to v are not observed.
+	v := point{7, 8}
+	a.Store(v)
+	v.x = 0
+	if got := a.Load(); got != (point{7, 8}) {
+		t.Errorf("Load() = %v after changing the stored variable, want %v", got, point{7, 8})
+	}
+}
+
+func TestAtomicValue(t *testing.T) {
+	var a AtomicValue[string]
+	if got := a.Load(); got != "" {
+		t.Errorf("zero Load() = %q, want \"\"", got)
+	}
+	if old := a.Swap("a"); old != "" {
+		t.Errorf("Swap() = %q, want \"\"", old)
+	}
+	if !a.CompareAndSwap("a", "b") || a.CompareAndSwap("a", "c") {
+		t.Error("CompareAndSwap(a, b), CompareAndSwap(a, c) did not return true, false")
+	}
+	if got := a.Load(); got != "b" {
+		t.Errorf("Load() = %q, want %q", got, "b")
+	}
+}
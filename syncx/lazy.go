@@ -0,0 +1,53 @@
+package syncx
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Lazy computes a value on first use. Unlike a bare sync.Once it returns
+// the value typed and can be reset. It is safe for concurrent use; create
+// it with NewLazy.
+type Lazy[T any] struct {
+	init  func() T
+	state atomic.Pointer[lazyState[T]]
+}
+
+// lazyState is one generation of a Lazy. Reset swaps in a fresh generation
+// instead of touching the old one, so callers still inside Value are
+// unaffected.
+type lazyState[T any] struct {
+	once  sync.Once
+	value T
+	done  atomic.Bool
+}
+
+// NewLazy returns a Lazy that calls init on first access.
+func NewLazy[T any](init func() T) *Lazy[T] {
+	l := &Lazy[T]{init: init}
+	l.state.Store(&lazyState[T]{})
+	return l
+}
+
+// Value returns the value, calling init if it has not been computed yet.
+// Concurrent callers wait for the same call to init.
+func (l *Lazy[T]) Value() T {
+	s := l.state.Load()
+	s.once.Do(func() {
+		s.value = l.init()
+		s.done.Store(true)
+	})
+	return s.value
+}
+
+// IsComputed reports whether the value has been computed since the Lazy
+// was created or last reset.
+func (l *Lazy[T]) IsComputed() bool {
+	return l.state.Load().done.Load()
+}
+
+// Reset discards the cached value, so the next call to Value calls init
+// again.
+func (l *Lazy[T]) Reset() {
+	l.state.Store(&lazyState[T]{})
+}
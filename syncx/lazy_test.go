@@ -0,0 +1,93 @@
+package syncx
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestLazyInitOnce(t *testing.T) {
+	var calls atomic.Int32
+	l := NewLazy(func() int { return int(calls.Add(1)) * 10 })
+	if l.IsComputed() {
+		t.Error("IsComputed() = true before first Value")
+	}
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			if v := l.Value(); v != 10 {
+				t.Errorf("Value() = %d, want 10", v)
+			}
+		}()
+	}
+	close(start)
+	wg.Wait()
+	if n := calls.Load(); n != 1 {
+		t.Errorf("init called %d times, want 1", n)
+	}
+	if !l.IsComputed() {
+		t.Error("IsComputed() = false after Value")
+	}
+}
+
+func TestLazyResetDuringValue(t *testing.T) {
+	var calls atomic.Int32
+	started, release := make(chan struct{}), make(chan struct{})
+	l := NewLazy(func() int {
+		n := calls.Add(1)
+		if n == 1 {
+			close(started)
+			<-release
+		}
+		return int(n)
+	})
+	done := make(chan int)
+	go func() { done <- l.Value() }()
+	<-started
+	l.Reset()
+	close(release)
+
+	// The call in flight finishes its own generation, the reset one starts
+	// afresh.
+	if v := <-done; v != 1 {
+		t.Errorf("Value() in flight during Reset = %d, want 1", v)
+	}
+	if l.IsComputed() {
+		t.Error("IsComputed() = true after Reset")
+	}
+	if v := l.Value(); v != 2 {
+		t.Errorf("Value() after Reset = %d, want 2", v)
+	}
+	if v := l.Value(); v != 2 || calls.Load() != 2 {
+		t.Errorf("Value() = %d with %d calls to init, want 2 and 2", v, calls.Load())
+	}
+}
+
+func TestLazyConcurrentReset(t *testing.T) {
+	var calls atomic.Int32
+	l := NewLazy(func() int { return int(calls.Add(1)) })
+	var wg sync.WaitGroup
+	for i := range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				if i%4 == 0 {
+					l.Reset()
+				} else if v := l.Value(); v < 1 {
+					t.Errorf("Value() = %d, want a computed value", v)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	// Every generation calls init at most once, and there is one per Reset
+	// plus the initial one.
+	if n := calls.Load(); n > 5*100+1 {
+		t.Errorf("init called %d times for at most %d generations", n, 5*100+1)
+	}
+}
@@ -0,0 +1,99 @@
+package syncx
+
+import (
+	"slices"
+	"sync"
+)
+
+// Mutex guards a value of type T, which can only be reached by locking the
+// mutex. The zero value is an unlocked mutex guarding the zero value of T.
+type Mutex[T any] struct {
+	mu sync.Mutex
+	v  T
+}
+
+// NewMutex returns an unlocked mutex guarding v.
+func NewMutex[T any](v T) *Mutex[T] {
+	return &Mutex[T]{v: v}
+}
+
+// Lock locks the mutex and returns a pointer to the guarded value. The
+// pointer must not be used after calling Unlock.
+func (m *Mutex[T]) Lock() *T {
+	m.mu.Lock()
+	return &m.v
+}
+
+// Unlock unlocks the mutex.
+func (m *Mutex[T]) Unlock() {
+	m.mu.Unlock()
+}
+
+// Do calls f with the guarded value while holding the lock and unlocks the
+// mutex when f returns, even if it panics.
+func Do[T any](m *Mutex[T], f func(*T)) {
+	v := m.Lock()
+	defer m.Unlock()
+	f(v)
+}
+
+// Semaphore hands out a fixed set of values, so that at most as many
+// holders as there are values work at the same time, each with a value of
+// its own. Create it with NewSemaphore.
+type Semaphore[T any] struct {
+	free chan *T
+	mu   sync.Mutex
+	held map[*T]bool
+}
+
+// NewSemaphore returns a semaphore for copies of the given values, so that
+// the caller keeps no access to them outside of Acquire and Release. It
+// panics if no values are given.
+func NewSemaphore[T any](values ...T) *Semaphore[T] {
+	if len(values) == 0 {
+		panic("syncx: Semaphore needs at least one value")
+	}
+	values = slices.Clone(values)
+	s := &Semaphore[T]{free: make(chan *T, len(values)), held: make(map[*T]bool, len(values))}
+	for i := range values {
+		s.free <- &values[i]
+	}
+	return s
+}
+
+// Acquire blocks until a value is free and returns a pointer to it. The
+// caller has exclusive access until it passes the pointer to Release.
+func (s *Semaphore[T]) Acquire() *T {
+	return s.hold(<-s.free)
+}
+
+// TryAcquire is like Acquire but returns nil and false instead of blocking
+// if no value is free.
+func (s *Semaphore[T]) TryAcquire() (*T, bool) {
+	select {
+	case v := <-s.free:
+		return s.hold(v), true
+	default:
+		return nil, false
+	}
+}
+
+func (s *Semaphore[T]) hold(v *T) *T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.held[v] = true
+	return v
+}
+
+// Release returns a value obtained from Acquire or TryAcquire. It panics if
+// v is not currently held, e.g. because it was already released.
+func (s *Semaphore[T]) Release(v *T) {
+	s.mu.Lock()
+	if !s.held[v] {
+		s.mu.Unlock()
+		panic("syncx: Release of a Semaphore value that is not held")
+	}
+	delete(s.held, v)
+	s.mu.Unlock()
+	s.free <- v // Never blocks, there is room for every held value
+}
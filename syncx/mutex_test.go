@@ -0,0 +1,88 @@
+package syncx
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestMutexDoConcurrent(t *testing.T) {
+	m := NewMutex(map[string]int{})
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				Do(m, func(counts *map[string]int) { (*counts)["n"]++ })
+			}
+		}()
+	}
+	wg.Wait()
+	if got := m.Lock(); (*got)["n"] != 5000 {
+		t.Errorf("count = %d, want 5000", (*got)["n"])
+	}
+	m.Unlock()
+}
+
+func TestDoUnlocksOnPanic(t *testing.T) {
+	var m Mutex[int]
+	func() {
+		defer func() { _ = recover() }()
+		Do(&m, func(*int) { panic("boom") })
+	}()
+	*m.Lock() = 1 // Would deadlock if Do had kept the lock
+	m.Unlock()
+}
+
+func TestSemaphoreExclusive(t *testing.T) {
+	s := NewSemaphore(0, 0, 0)
+	var wg sync.WaitGroup
+	for range 30 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				v := s.Acquire()
+				*v++ // Raced if two holders shared a value
+				s.Release(v)
+			}
+		}()
+	}
+	wg.Wait()
+
+	total := 0
+	for range 3 {
+		v, ok := s.TryAcquire()
+		if !ok {
+			t.Fatal("TryAcquire failed with all values released")
+		}
+		total += *v
+	}
+	if total != 3000 {
+		t.Errorf("total = %d, want 3000", total)
+	}
+	if _, ok := s.TryAcquire(); ok {
+		t.Error("TryAcquire succeeded with no value free")
+	}
+}
+
+func TestNewSemaphoreCopiesValues(t *testing.T) {
+	values := []int{1}
+	s := NewSemaphore(values...)
+	values[0] = 2
+	if v := s.Acquire(); *v != 1 {
+		t.Errorf("acquired %d, want 1", *v)
+	}
+}
+
+func TestSemaphoreDoubleRelease(t *testing.T) {
+	s := NewSemaphore(1, 2)
+	v := s.Acquire()
+	s.Release(v)
+	defer func() {
+		if recover() == nil {
+			t.Error("second Release did not panic")
+		}
+	}()
+	s.Release(v)
+}
@@ -0,0 +1,57 @@
+package syncx
+
+import "sync"
+
+// Pool is a typed wrapper around sync.Pool. T should be a pointer type:
+// like sync.Pool, Put stores its argument in an interface, which allocates
+// for every value that is not pointer-shaped and defeats the purpose of
+// pooling.
+type Pool[T any] struct {
+	pool  sync.Pool
+	reset func(*T)
+}
+
+// PoolOption configures a Pool.
+type PoolOption[T any] func(*Pool[T])
+
+// WithReset registers a function that is called on every object passed to
+// Put before it is returned to the pool. Passing the object by address
+// costs Put one small allocation.
+func WithReset[T any](reset func(*T)) PoolOption[T] {
+	return func(p *Pool[T]) {
+		p.reset = reset
+	}
+}
+
+// NewPool returns a pool that creates new objects with newFn when empty.
+func NewPool[T any](newFn func() T, opts ...PoolOption[T]) *Pool[T] {
+	p := &Pool[T]{}
+	p.pool.New = func() any { return newFn() }
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// Get returns an object from the pool, creating a new one if needed. If
+// newFn returned a nil interface, Get returns the zero value of T.
+func (p *Pool[T]) Get() T {
+	v, _ := p.pool.Get().(T)
+	return v
+}
+
+// Put resets v and returns it to the pool.
+func (p *Pool[T]) Put(v T) {
+	if p.reset != nil {
+		p.putReset(v)
+		return
+	}
+	p.pool.Put(v)
+}
+
+// putReset is separate from Put, so that only pools with a reset function
+// pay for moving v to the heap to take its address.
+func (p *Pool[T]) putReset(v T) {
+	p.reset(&v)
+	p.pool.Put(v)
+}
@@ -0,0 +1,109 @@
+package syncx
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+)
+
+type poolStruct struct {
+	buf []byte
+	n   int
+}
+
+func TestPoolGetCreates(t *testing.T) {
+	created := 0
+	p := NewPool(func() *poolStruct {
+		created++
+		return &poolStruct{n: 1}
+	})
+	if v := p.Get(); v == nil || v.n != 1 {
+		t.Errorf("Get() = %+v, want a new object", v)
+	}
+	if created != 1 {
+		t.Errorf("newFn called %d times, want 1", created)
+	}
+}
+
+func TestPoolReset(t *testing.T) {
+	p := NewPool(func() *poolStruct { return &poolStruct{} },
+		WithReset(func(v **poolStruct) {
+			(*v).buf = (*v).buf[:0]
+			(*v).n = 0
+		}))
+	v := p.Get()
+	v.buf = append(v.buf, "stale"...)
+	v.n = 42
+	p.Put(v)
+	// The pool may have dropped v, but v itself must have been reset.
+	if len(v.buf) != 0 || v.n != 0 {
+		t.Errorf("object after Put = %+v, want it reset", v)
+	}
+	if got := p.Get(); len(got.buf) != 0 || got.n != 0 {
+		t.Errorf("Get() after Put = %+v, want a clean object", got)
+	}
+}
+
+func TestPoolNilInterface(t *testing.T) {
+	p := NewPool(func() error { return nil })
+	if v := p.Get(); v != nil {
+		t.Errorf("Get() = %v, want nil", v)
+	}
+}
+
+func TestPoolConcurrent(t *testing.T) {
+	p := NewPool(func() *bytes.Buffer { return new(bytes.Buffer) }, WithReset(func(b **bytes.Buffer) { (*b).Reset() }))
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				b := p.Get()
+				if b.Len() != 0 {
+					t.Errorf("Get() returned a buffer holding %q", b.String())
+				}
+				b.WriteString("data")
+				p.Put(b)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func BenchmarkPool(b *testing.B) {
+	p := NewPool(func() *poolStruct { return &poolStruct{} })
+	for range b.N {
+		v := p.Get()
+		v.n++
+		p.Put(v)
+	}
+}
+
+func BenchmarkSyncPool(b *testing.B) {
+	p := sync.Pool{New: func() any { return &poolStruct{} }}
+	for range b.N {
+		v := p.Get().(*poolStruct)
+		v.n++
+		p.Put(v)
+	}
+}
+
+func BenchmarkPoolReset(b *testing.B) {
+	p := NewPool(func() *poolStruct { return &poolStruct{} }, WithReset(func(v **poolStruct) { (*v).n = 0 }))
+	for range b.N {
+		v := p.Get()
+		v.n++
+		p.Put(v)
+	}
+}
+
+func BenchmarkSyncPoolReset(b *testing.B) {
+	p := sync.Pool{New: func() any { return &poolStruct{} }}
+	for range b.N {
+		v := p.Get().(*poolStruct)
+		v.n++
+		v.n = 0
+		p.Put(v)
+	}
+}
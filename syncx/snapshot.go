@@ -0,0 +1,50 @@
+package syncx
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"projektarbeit-go-generics/event"
+)
+
+// Snapshot shares a value between goroutines with copy-on-write semantics.
+// Reads never block: they copy the current version. Writes modify a private
+// copy and publish it atomically, so readers see either the old or the new
+// version, never a partial update. Copies are shallow, so T should not
+// share mutable state through pointers, slices or maps. The zero value
+// holds the zero value of T.
+type Snapshot[T any] struct {
+	p    atomic.Pointer[T]
+	mu   sync.Mutex // serializes writers
+	subs event.SafeObservable[T]
+}
+
+// NewSnapshot returns a snapshot holding v.
+func NewSnapshot[T any](v T) *Snapshot[T] {
+	s := &Snapshot[T]{}
+	s.p.Store(&v)
+	return s
+}
+
+// Read returns a copy of the current version.
+func (s *Snapshot[T]) Read() T {
+	return load(s.p.Load())
+}
+
+// Write applies f to a copy of the current version, publishes the result
+// and then passes it to all subscribers. Writes are serialized; f and the
+// subscribers must not call Write themselves.
+func (s *Snapshot[T]) Write(f func(*T)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v := load(s.p.Load())
+	f(&v)
+	s.p.Store(&v)
+	s.subs.Emit(v)
+}
+
+// Subscribe registers handler to be called with every new version and
+// returns an idempotent function that removes it again.
+func (s *Snapshot[T]) Subscribe(handler func(T)) (cancel func()) {
+	return s.subs.Subscribe(handler)
+}
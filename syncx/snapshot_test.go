@@ -0,0 +1,104 @@
+package syncx
+
+import (
+	"slices"
+	"sync"
+	"testing"
+)
+
+// pair is consistent if both fields are equal. Writers break that while f
+// runs, so a reader that sees a partial write finds them differing.
+type pair struct{ a, b int }
+
+func TestSnapshotNoPartialReads(t *testing.T) {
+	s := NewSnapshot(pair{})
+	const writers, writes = 4, 200
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				if p := s.Read(); p.a != p.b {
+					t.Errorf("Read() = %+v, a partial write", p)
+					return
+				}
+			}
+		}()
+	}
+	var writeWG sync.WaitGroup
+	for range writers {
+		writeWG.Add(1)
+		go func() {
+			defer writeWG.Done()
+			for range writes {
+				s.Write(func(p *pair) {
+					p.a++
+					p.b++
+				})
+			}
+		}()
+	}
+	writeWG.Wait()
+	close(stop)
+	wg.Wait()
+	if p := s.Read(); p != (pair{writers * writes, writers * writes}) {
+		t.Errorf("Read() = %+v after all writes, want a = b = %d", p, writers*writes)
+	}
+}
+
+func TestSnapshotSubscribersSeeEveryVersion(t *testing.T) {
+	var s Snapshot[int]
+	var mu sync.Mutex
+	var first, second []int
+	s.Subscribe(func(v int) {
+		mu.Lock()
+		first = append(first, v)
+		mu.Unlock()
+	})
+	cancel := s.Subscribe(func(v int) {
+		mu.Lock()
+		second = append(second, v)
+		mu.Unlock()
+	})
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 50 {
+				s.Write(func(v *int) { *v++ })
+			}
+		}()
+	}
+	wg.Wait()
+	cancel()
+	s.Write(func(v *int) { *v++ })
+
+	want := make([]int, 501)
+	for i := range want {
+		want[i] = i + 1
+	}
+	if !slices.Equal(first, want) {
+		t.Errorf("first subscriber got %d versions, want 1 to 501 in order", len(first))
+	}
+	if !slices.Equal(second, want[:500]) {
+		t.Errorf("canceled subscriber got %d versions, want 1 to 500 in order", len(second))
+	}
+}
+
+func TestSnapshotWriteCopies(t *testing.T) {
+	s := NewSnapshot(pair{1, 1})
+	before := s.Read()
+	s.Write(func(p *pair) { p.a = 2 })
+	if before != (pair{1, 1}) || s.Read() != (pair{2, 1}) {
+		t.Errorf("Read() before and after Write = %+v, %+v, want {1 1}, {2 1}", before, s.Read())
+	}
+}
@@ -0,0 +1,68 @@
+// Package syncx provides type-safe generic wrappers around the primitives of
+// sync and sync/atomic.
+package syncx
+
+import "sync"
+
+// SyncMap is a typed wrapper around sync.Map. The zero value is an empty map
+// ready to use. A SyncMap must not be copied after first use.
+type SyncMap[K comparable, V any] struct {
+	m sync.Map
+}
+
+// Store sets the value for key.
+func (m *SyncMap[K, V]) Store(key K, value V) {
+	m.m.Store(key, value)
+}
+
+// Load returns the value stored for key.
+func (m *SyncMap[K, V]) Load(key K) (V, bool) {
+	v, ok := m.m.Load(key)
+	value, _ := v.(V) // Comma-ok, because a stored nil interface does not assert to V
+	return value, ok
+}
+
+// LoadOrStore returns the existing value for key if present. Otherwise it
+// stores and returns value. The result is true if the value was loaded.
+func (m *SyncMap[K, V]) LoadOrStore(key K, value V) (V, bool) {
+	v, loaded := m.m.LoadOrStore(key, value)
+	actual, _ := v.(V)
+	return actual, loaded
+}
+
+// LoadAndDelete deletes key and returns its previous value.
+func (m *SyncMap[K, V]) LoadAndDelete(key K) (V, bool) {
+	v, loaded := m.m.LoadAndDelete(key)
+	value, _ := v.(V)
+	return value, loaded
+}
+
+// Delete removes key.
+func (m *SyncMap[K, V]) Delete(key K) {
+	m.m.Delete(key)
+}
+
+// Range calls f for each key and value, stopping when f returns false.
+func (m *SyncMap[K, V]) Range(f func(K, V) bool) {
+	m.m.Range(func(key, value any) bool {
+		k, _ := key.(K)
+		v, _ := value.(V)
+		return f(k, v)
+	})
+}
+
+// ComparableSyncMap is a SyncMap whose values are comparable, which allows
+// the compare-and-swap operations of sync.Map.
+type ComparableSyncMap[K, V comparable] struct {
+	SyncMap[K, V]
+}
+
+// CompareAndSwap swaps the value for key to new if it is currently old.
+func (m *ComparableSyncMap[K, V]) CompareAndSwap(key K, old, new V) bool {
+	return m.m.CompareAndSwap(key, old, new)
+}
+
+// CompareAndDelete deletes key if its value is currently old.
+func (m *ComparableSyncMap[K, V]) CompareAndDelete(key K, old V) bool {
+	return m.m.CompareAndDelete(key, old)
+}
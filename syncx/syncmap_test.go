@@ -0,0 +1,165 @@
+package syncx
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestSyncMap(t *testing.T) {
+	var m SyncMap[string, int]
+	if v, ok := m.Load("a"); v != 0 || ok {
+		t.Errorf("Load on the zero map = %d, %v, want 0, false", v, ok)
+	}
+	m.Store("a", 1)
+	if v, ok := m.Load("a"); v != 1 || !ok {
+		t.Errorf("Load(a) = %d, %v, want 1, true", v, ok)
+	}
+	if v, loaded := m.LoadOrStore("a", 2); v != 1 || !loaded {
+		t.Errorf("LoadOrStore(a, 2) = %d, %v, want 1, true", v, loaded)
+	}
+	if v, loaded := m.LoadOrStore("b", 2); v != 2 || loaded {
+		t.Errorf("LoadOrStore(b, 2) = %d, %v, want 2, false", v, loaded)
+	}
+	if v, loaded := m.LoadAndDelete("a"); v != 1 || !loaded {
+		t.Errorf("LoadAndDelete(a) = %d, %v, want 1, true", v, loaded)
+	}
+	m.Delete("b")
+	m.Range(func(k string, v int) bool {
+		t.Errorf("Range visited %s=%d in an empty map", k, v)
+		return true
+	})
+}
+
+func TestSyncMapNilValues(t *testing.T) {
+	var m SyncMap[any, error]
+	m.Store(nil, nil)
+	if v, ok := m.Load(nil); v != nil || !ok {
+		t.Errorf("Load(nil) = %v, %v, want nil, true", v, ok)
+	}
+	if v, loaded := m.LoadOrStore(nil, nil); v != nil || !loaded {
+		t.Errorf("LoadOrStore(nil, nil) = %v, %v, want nil, true", v, loaded)
+	}
+	visited := 0
+	m.Range(func(k any, v error) bool {
+		visited++
+		return true
+	})
+	if visited != 1 {
+		t.Errorf("Range visited %d entries, want 1", visited)
+	}
+	if v, loaded := m.LoadAndDelete(nil); v != nil || !loaded {
+		t.Errorf("LoadAndDelete(nil) = %v, %v, want nil, true", v, loaded)
+	}
+}
+
+func TestSyncMapRangeStops(t *testing.T) {
+	var m SyncMap[int, int]
+	for i := range 10 {
+		m.Store(i, i)
+	}
+	visited := 0
+	m.Range(func(int, int) bool {
+		visited++
+		return visited < 3
+	})
+	if visited != 3 {
+		t.Errorf("Range visited %d entries, want 3", visited)
+	}
+}
+
+func TestComparableSyncMap(t *testing.T) {
+	var m ComparableSyncMap[string, int]
+	m.Store("a", 1)
+	if m.CompareAndSwap("a", 2, 3) {
+		t.Error("CompareAndSwap with a wrong old value succeeded")
+	}
+	if !m.CompareAndSwap("a", 1, 3) {
+		t.Error("CompareAndSwap with the current value failed")
+	}
+	if m.CompareAndDelete("a", 1) {
+		t.Error("CompareAndDelete with a wrong old value succeeded")
+	}
+	if !m.CompareAndDelete("a", 3) {
+		t.Error("CompareAndDelete with the current value failed")
+	}
+	if _, ok := m.Load("a"); ok {
+		t.Error("key still present after CompareAndDelete")
+	}
+}
+
+func TestSyncMapConcurrent(t *testing.T) {
+	var m SyncMap[int, string]
+	var wg sync.WaitGroup
+	for g := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 100 {
+				k := g*100 + i
+				m.Store(k, strconv.Itoa(k))
+				if v, ok := m.Load(k); !ok || v != strconv.Itoa(k) {
+					t.Errorf("Load(%d) = %q, %v", k, v, ok)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	n := 0
+	m.Range(func(int, string) bool { n++; return true })
+	if n != 800 {
+		t.Errorf("map has %d entries, want 800", n)
+	}
+}
+
+// mutexMap is the mutex-guarded map the benchmarks compare SyncMap to.
+type mutexMap[K comparable, V any] struct {
+	mu sync.RWMutex
+	m  map[K]V
+}
+
+func (m *mutexMap[K, V]) Load(key K) (V, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	v, ok := m.m[key]
+	return v, ok
+}
+
+func (m *mutexMap[K, V]) Store(key K, value V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.m[key] = value
+}
+
+const benchKeys = 1024
+
+// benchmarkReadHeavy does one store per 100 loads from parallel goroutines.
+// The advantage of SyncMap grows with the number of readers, so compare
+// the two with -cpu set to several values.
+func benchmarkReadHeavy(b *testing.B, load func(int) (int, bool), store func(int, int)) {
+	for i := range benchKeys {
+		store(i, i)
+	}
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			if i%100 == 0 {
+				store(i%benchKeys, i)
+			} else {
+				load(i % benchKeys)
+			}
+			i++
+		}
+	})
+}
+
+func BenchmarkSyncMapReadHeavy(b *testing.B) {
+	var m SyncMap[int, int]
+	benchmarkReadHeavy(b, m.Load, m.Store)
+}
+
+func BenchmarkMutexMapReadHeavy(b *testing.B) {
+	m := &mutexMap[int, int]{m: make(map[int]int)}
+	benchmarkReadHeavy(b, m.Load, m.Store)
+}
@@ -0,0 +1,36 @@
+package main
+
+import "fmt"
+
+type customInt[T int | int8 | int16 | int32 | int64] struct {
+	value T
+}
+
+type tildeInt[T ~int] struct {
+	value T
+}
+
+type MyInt int
+
+func (n MyInt) String() string { return fmt.Sprint(int(n)) }
+
+type MyStruct struct {
+	Field1 string
+	Field2 int
+}
+
+type Number interface{ ~int | ~float64 }
+
+func show[T fmt.Stringer](v T) string { return v.String() }
+
+func sum[N Number](ns ...N) N {
+	var total N
+	for _, n := range ns {
+		total += n
+	}
+	return total
+}
+
+func first[T any](xs []T) T { return xs[0] }
+
+func main() {}
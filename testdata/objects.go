@@ -0,0 +1,17 @@
+package main
+
+type MyInt int
+
+type Pair[K comparable, V ~int | ~int64] struct {
+	Key   K
+	Value V
+}
+
+func isEven(n MyInt) (even bool) { return n%2 == 0 }
+
+// inspect: MyInt, Pair, isEven, missing
+func main() {
+	p := Pair[string, MyInt]{}
+	// inspect: p, p.Key
+	_ = p
+}
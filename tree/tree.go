@@ -0,0 +1,105 @@
+// Package tree provides a generic n-ary tree.
+package tree
+
+import "projektarbeit-go-generics/collections"
+
+// Node is a tree node holding a value and any number of children.
+type Node[T any] struct {
+	Value    T
+	Children []*Node[T]
+}
+
+// Tree is an n-ary tree. A nil Root is the empty tree.
+type Tree[T any] struct {
+	Root *Node[T]
+}
+
+// NewNode returns a node holding value with the given children.
+func NewNode[T any](value T, children ...*Node[T]) *Node[T] {
+	return &Node[T]{Value: value, Children: children}
+}
+
+// Depth returns the number of nodes on the longest path from n down to a
+// leaf. The depth of a nil node is 0.
+func (n *Node[T]) Depth() int {
+	if n == nil {
+		return 0
+	}
+	depth := 0
+	for _, c := range n.Children {
+		depth = max(depth, c.Depth())
+	}
+	return depth + 1
+}
+
+// Size returns the number of nodes in the subtree rooted at n.
+func (n *Node[T]) Size() int {
+	if n == nil {
+		return 0
+	}
+	size := 1
+	for _, c := range n.Children {
+		size += c.Size()
+	}
+	return size
+}
+
+// Depth returns the depth of the tree.
+func (t *Tree[T]) Depth() int {
+	return t.Root.Depth()
+}
+
+// Size returns the number of nodes in the tree.
+func (t *Tree[T]) Size() int {
+	return t.Root.Size()
+}
+
+// PreOrder visits the value of every node before the values of its children.
+func PreOrder[T any](root *Node[T], visit func(T)) {
+	if root == nil {
+		return
+	}
+	visit(root.Value)
+	for _, c := range root.Children {
+		PreOrder(c, visit)
+	}
+}
+
+// PostOrder visits the value of every node after the values of its children.
+func PostOrder[T any](root *Node[T], visit func(T)) {
+	if root == nil {
+		return
+	}
+	for _, c := range root.Children {
+		PostOrder(c, visit)
+	}
+	visit(root.Value)
+}
+
+// LevelOrder visits the values level by level, starting at the root.
+func LevelOrder[T any](root *Node[T], visit func(T)) {
+	if root == nil {
+		return
+	}
+	var queue collections.Queue[*Node[T]]
+	queue.Enqueue(root)
+	for n, ok := queue.Dequeue(); ok; n, ok = queue.Dequeue() {
+		visit(n.Value)
+		for _, c := range n.Children {
+			queue.Enqueue(c)
+		}
+	}
+}
+
+// MapTree returns a tree of the same shape as root holding f applied to
+// every value.
+func MapTree[T, U any](root *Node[T], f func(T) U) *Node[U] {
+	if root == nil {
+		return nil
+	}
+	mapped := &Node[U]{Value: f(root.Value), Children: make([]*Node[U], len(root.Children))}
+	for i, c := range root.Children {
+		mapped.Children[i] = MapTree(c, f)
+	}
+	return mapped
+}
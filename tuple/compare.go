@@ -0,0 +1,25 @@
+package tuple
+
+import "cmp"
+
+// ComparePairs compares x and y lexicographically and returns -1, 0 or +1
+// like cmp.Compare. cmp.Ordered only admits basic types, so Pair itself can
+// never satisfy it; ComparePairs can be passed to slices.SortFunc instead.
+func ComparePairs[A, B cmp.Ordered](x, y Pair[A, B]) int {
+	if c := cmp.Compare(x.First, y.First); c != 0 {
+		return c
+	}
+	return cmp.Compare(x.Second, y.Second)
+}
+
+// CompareTriples compares x and y lexicographically and returns -1, 0 or +1
+// like cmp.Compare.
+func CompareTriples[A, B, C cmp.Ordered](x, y Triple[A, B, C]) int {
+	if c := cmp.Compare(x.First, y.First); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(x.Second, y.Second); c != 0 {
+		return c
+	}
+	return cmp.Compare(x.Third, y.Third)
+}
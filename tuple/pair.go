@@ -0,0 +1,30 @@
+// Package tuple provides small generic product types.
+package tuple
+
+import "fmt"
+
+// Pair holds two values of possibly different types.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// NewPair returns a Pair holding a and b.
+func NewPair[A, B any](a A, b B) Pair[A, B] {
+	return Pair[A, B]{First: a, Second: b}
+}
+
+// Unpack returns the two values of p.
+func (p Pair[A, B]) Unpack() (A, B) {
+	return p.First, p.Second
+}
+
+// String formats p as (First, Second).
+func (p Pair[A, B]) String() string {
+	return fmt.Sprintf("(%v, %v)", p.First, p.Second)
+}
+
+// SwapPair returns a Pair with the values of p exchanged.
+func SwapPair[A, B any](p Pair[A, B]) Pair[B, A] {
+	return Pair[B, A]{First: p.Second, Second: p.First}
+}
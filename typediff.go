@@ -0,0 +1,305 @@
+package main
+
+import (
+	"fmt"
+	"go/token"
+	"go/types"
+	"strings"
+)
+
+// DiffKind tells how a part of a type changed.
+type DiffKind int
+
+const (
+	// Added parts only exist in the second type.
+	Added DiffKind = iota
+	// Removed parts only exist in the first type.
+	Removed
+	// Changed parts exist in both types but differ.
+	Changed
+)
+
+func (k DiffKind) String() string {
+	switch k {
+	case Added:
+		return "added"
+	case Removed:
+		return "removed"
+	case Changed:
+		return "changed"
+	}
+	return "unknown"
+}
+
+// TypeDiffEntry is one structural difference between two types. Path
+// locates it from the compared type in JSON-pointer style: fields by name
+// and methods, parameters, results and type parameters by index, e.g.
+// ".Field1", ".Methods[0].Params[1]" or ".Elem.Key". Indices refer to the
+// first type, except for added parts, which only exist in the second.
+type TypeDiffEntry struct {
+	Path   string
+	Kind   DiffKind
+	Before string
+	After  string
+}
+
+// TypeDiff reports the structural differences between a and b: added,
+// removed and renamed fields and methods, changed signatures, type
+// parameter counts and underlying kinds. a and b themselves are compared
+// by structure even if they are distinct named types; nested named types
+// are compared by identity only.
+func TypeDiff(a, b types.Type) []TypeDiffEntry {
+	var d typeDiffer
+	d.named("", a, b)
+	d.diff("", a.Underlying(), b.Underlying())
+	return d.entries
+}
+
+type typeDiffer struct {
+	entries []TypeDiffEntry
+}
+
+func (d *typeDiffer) add(path string, kind DiffKind, before, after string) {
+	d.entries = append(d.entries, TypeDiffEntry{Path: path, Kind: kind, Before: before, After: after})
+}
+
+// named compares the type parameters and declared methods of named types.
+func (d *typeDiffer) named(path string, a, b types.Type) {
+	na, _ := a.(*types.Named)
+	nb, _ := b.(*types.Named)
+	ta, tb := typeParams(na), typeParams(nb)
+	for i := range max(len(ta), len(tb)) {
+		ipath := fmt.Sprintf("%s.TypeParams[%d]", path, i)
+		switch {
+		case i >= len(tb):
+			d.add(ipath, Removed, typeParamString(ta[i]), "")
+		case i >= len(ta):
+			d.add(ipath, Added, "", typeParamString(tb[i]))
+		}
+	}
+	d.methods(path, declaredMethods(na), declaredMethods(nb))
+}
+
+func typeParams(n *types.Named) []*types.TypeParam {
+	if n == nil {
+		return nil
+	}
+	tparams := make([]*types.TypeParam, n.TypeParams().Len())
+	for i := range tparams {
+		tparams[i] = n.TypeParams().At(i)
+	}
+	return tparams
+}
+
+func typeParamString(tp *types.TypeParam) string {
+	return tp.Obj().Name() + " " + tp.Constraint().String()
+}
+
+func declaredMethods(n *types.Named) []*types.Func {
+	if n == nil {
+		return nil
+	}
+	methods := make([]*types.Func, n.NumMethods())
+	for i := range methods {
+		methods[i] = n.Method(i)
+	}
+	return methods
+}
+
+func (d *typeDiffer) methods(path string, a, b []*types.Func) {
+	byName := make(map[string]*types.Func, len(b))
+	for _, m := range b {
+		byName[m.Name()] = m
+	}
+	seen := make(map[string]bool, len(a))
+	for i, ma := range a {
+		seen[ma.Name()] = true
+		mpath := fmt.Sprintf("%s.Methods[%d]", path, i)
+		mb, ok := byName[ma.Name()]
+		if !ok {
+			d.add(mpath, Removed, ma.Name()+methodSignature(ma), "")
+			continue
+		}
+		d.signature(mpath, ma.Type().(*types.Signature), mb.Type().(*types.Signature))
+	}
+	for i, mb := range b {
+		if !seen[mb.Name()] {
+			d.add(fmt.Sprintf("%s.Methods[%d]", path, i), Added, "", mb.Name()+methodSignature(mb))
+		}
+	}
+}
+
+// diff compares two types that appear within the compared types.
+func (d *typeDiffer) diff(path string, a, b types.Type) {
+	if types.Identical(a, b) {
+		return
+	}
+	_, aNamed := a.(*types.Named)
+	_, bNamed := b.(*types.Named)
+	if aNamed || bNamed || kindName(a) != kindName(b) {
+		d.add(path, Changed, a.String(), b.String())
+		return
+	}
+	switch a := a.(type) {
+	case *types.Struct:
+		d.structs(path, a, b.(*types.Struct))
+	case *types.Interface:
+		d.interfaces(path, a, b.(*types.Interface))
+	case *types.Signature:
+		d.signature(path, a, b.(*types.Signature))
+	case *types.Pointer:
+		d.diff(path+".Elem", a.Elem(), b.(*types.Pointer).Elem())
+	case *types.Slice:
+		d.diff(path+".Elem", a.Elem(), b.(*types.Slice).Elem())
+	case *types.Array:
+		if a.Len() != b.(*types.Array).Len() {
+			d.add(path+".Len", Changed, fmt.Sprint(a.Len()), fmt.Sprint(b.(*types.Array).Len()))
+		}
+		d.diff(path+".Elem", a.Elem(), b.(*types.Array).Elem())
+	case *types.Map:
+		d.diff(path+".Key", a.Key(), b.(*types.Map).Key())
+		d.diff(path+".Elem", a.Elem(), b.(*types.Map).Elem())
+	case *types.Chan:
+		if a.Dir() != b.(*types.Chan).Dir() {
+			d.add(path+".Dir", Changed, a.String(), b.String())
+		}
+		d.diff(path+".Elem", a.Elem(), b.(*types.Chan).Elem())
+	default:
+		d.add(path, Changed, a.String(), b.String())
+	}
+}
+
+func (d *typeDiffer) structs(path string, a, b *types.Struct) {
+	fieldsA := make(map[string]*types.Var, a.NumFields())
+	for i := range a.NumFields() {
+		fieldsA[a.Field(i).Name()] = a.Field(i)
+	}
+	fieldsB := make(map[string]*types.Var, b.NumFields())
+	for i := range b.NumFields() {
+		fieldsB[b.Field(i).Name()] = b.Field(i)
+	}
+
+	renamed := make(map[string]bool) // Fields of b reported as renames
+	for i := range a.NumFields() {
+		fa := a.Field(i)
+		fpath := path + "." + fa.Name()
+		if fb, ok := fieldsB[fa.Name()]; ok {
+			d.diff(fpath, fa.Type(), fb.Type())
+			continue
+		}
+		// A field missing in b that has a new name but the same type at
+		// the same index was renamed.
+		if i < b.NumFields() {
+			fb := b.Field(i)
+			if _, ok := fieldsA[fb.Name()]; !ok && types.Identical(fa.Type(), fb.Type()) {
+				d.add(fpath, Changed, fieldString(fa), fieldString(fb))
+				renamed[fb.Name()] = true
+				continue
+			}
+		}
+		d.add(fpath, Removed, fieldString(fa), "")
+	}
+	for i := range b.NumFields() {
+		fb := b.Field(i)
+		if _, ok := fieldsA[fb.Name()]; !ok && !renamed[fb.Name()] {
+			d.add(path+"."+fb.Name(), Added, "", fieldString(fb))
+		}
+	}
+}
+
+func fieldString(f *types.Var) string {
+	if f.Embedded() {
+		return f.Type().String()
+	}
+	return f.Name() + " " + f.Type().String()
+}
+
+func (d *typeDiffer) interfaces(path string, a, b *types.Interface) {
+	methods := func(iface *types.Interface) []*types.Func {
+		m := make([]*types.Func, iface.NumMethods())
+		for i := range m {
+			m[i] = iface.Method(i)
+		}
+		return m
+	}
+	d.methods(path, methods(a), methods(b))
+	if termsA, termsB := constraintTerms(a), constraintTerms(b); !sameTerms(termsA, termsB) {
+		d.add(path+".Terms", Changed, termsString(termsA), termsString(termsB))
+	}
+}
+
+func sameTerms(a, b []*types.Term) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Tilde() != b[i].Tilde() || !types.Identical(a[i].Type(), b[i].Type()) {
+			return false
+		}
+	}
+	return true
+}
+
+func termsString(terms []*types.Term) string {
+	s := make([]string, len(terms))
+	for i, term := range terms {
+		s[i] = term.String()
+	}
+	return strings.Join(s, " | ")
+}
+
+func (d *typeDiffer) signature(path string, a, b *types.Signature) {
+	d.tuple(path+".Params", a.Params(), b.Params())
+	d.tuple(path+".Results", a.Results(), b.Results())
+	if a.Variadic() != b.Variadic() {
+		d.add(path+".Variadic", Changed, fmt.Sprint(a.Variadic()), fmt.Sprint(b.Variadic()))
+	}
+}
+
+func (d *typeDiffer) tuple(path string, a, b *types.Tuple) {
+	for i := range max(a.Len(), b.Len()) {
+		ipath := fmt.Sprintf("%s[%d]", path, i)
+		switch {
+		case i >= b.Len():
+			d.add(ipath, Removed, a.At(i).Type().String(), "")
+		case i >= a.Len():
+			d.add(ipath, Added, "", b.At(i).Type().String())
+		default:
+			d.diff(ipath, a.At(i).Type(), b.At(i).Type())
+		}
+	}
+}
+
+// typeDiff evaluates "TypeA TypeB" and lists the differences from TypeA to
+// TypeB.
+func typeDiff(c checkedPackage, pos token.Pos, scope *types.Scope, args string) string {
+	names := strings.Fields(args)
+	if len(names) != 2 {
+		return "\t<invalid directive, expected: TypeA TypeB>\n"
+	}
+	var ts [2]types.Type
+	for i, name := range names {
+		t, ok := lookupType(c.pkg, scope, pos, name)
+		if !ok {
+			return fmt.Sprintf("\t<type %s not found>\n", name)
+		}
+		ts[i] = t
+	}
+
+	entries := TypeDiff(ts[0], ts[1])
+	if len(entries) == 0 {
+		return "\t<no differences>\n"
+	}
+	buff := &strings.Builder{}
+	for _, e := range entries {
+		switch e.Kind {
+		case Added:
+			fmt.Fprintf(buff, "\t+ %s: %s\n", e.Path, e.After)
+		case Removed:
+			fmt.Fprintf(buff, "\t- %s: %s\n", e.Path, e.Before)
+		case Changed:
+			fmt.Fprintf(buff, "\t~ %s: %s -> %s\n", e.Path, e.Before, e.After)
+		}
+	}
+	return buff.String()
+}
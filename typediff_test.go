@@ -0,0 +1,130 @@
+package main
+
+import (
+	"go/types"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const typeDiffSource = `package main
+
+type MyStruct struct {
+	Name  string
+	Count int
+}
+
+func (MyStruct) String() string { return "" }
+
+type MyStructV2 struct {
+	Name  string
+	Count int64
+	Tags  []string
+}
+
+func (MyStructV2) String() string   { return "" }
+func (MyStructV2) Len(n int) int    { return n }
+
+type Renamed struct {
+	Title string
+	Count int
+}
+
+type Pair[A, B any] struct{}
+type Single[A any] struct{}
+
+type Scaler struct{}
+
+func (Scaler) Scale(a, b int) int { return a * b }
+
+type ScalerV2 struct{}
+
+func (ScalerV2) Scale(a int, b float64) int { return a }
+
+type Numbers interface{ ~int | ~float64 }
+type Ints interface{ ~int }
+
+type IntSlice []int
+type IntMap map[string]int
+`
+
+func typeDiffOf(t *testing.T, c checkedPackage, a, b string) []TypeDiffEntry {
+	t.Helper()
+	ta := c.pkg.Scope().Lookup(a)
+	tb := c.pkg.Scope().Lookup(b)
+	if ta == nil || tb == nil {
+		t.Fatalf("types %s and %s not found", a, b)
+	}
+	return TypeDiff(ta.Type(), tb.Type())
+}
+
+func TestTypeDiffAddedField(t *testing.T) {
+	c := checkSource(t, typeDiffSource)
+	got := typeDiffOf(t, c, "MyStruct", "MyStructV2")
+	want := []TypeDiffEntry{
+		{Path: ".Methods[1]", Kind: Added, After: "Len(n int) int"},
+		{Path: ".Count", Kind: Changed, Before: "int", After: "int64"},
+		{Path: ".Tags", Kind: Added, After: "Tags []string"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("TypeDiff(MyStruct, MyStructV2) = %+v, want %+v", got, want)
+	}
+	if got := typeDiffOf(t, c, "MyStruct", "MyStruct"); len(got) != 0 {
+		t.Errorf("TypeDiff(MyStruct, MyStruct) = %+v, want none", got)
+	}
+}
+
+func TestTypeDiffRenamedField(t *testing.T) {
+	c := checkSource(t, typeDiffSource)
+	got := typeDiffOf(t, c, "MyStruct", "Renamed")
+	want := []TypeDiffEntry{
+		{Path: ".Methods[0]", Kind: Removed, Before: "String() string"},
+		{Path: ".Name", Kind: Changed, Before: "Name string", After: "Title string"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("TypeDiff(MyStruct, Renamed) = %+v, want %+v", got, want)
+	}
+}
+
+func TestTypeDiffKinds(t *testing.T) {
+	c := checkSource(t, typeDiffSource)
+	for _, tc := range []struct {
+		a, b string
+		want TypeDiffEntry
+	}{
+		{"Pair", "Single", TypeDiffEntry{Path: ".TypeParams[1]", Kind: Removed, Before: "B any"}},
+		{"Single", "Pair", TypeDiffEntry{Path: ".TypeParams[1]", Kind: Added, After: "B any"}},
+		{"Scaler", "ScalerV2", TypeDiffEntry{Path: ".Methods[0].Params[1]", Kind: Changed, Before: "int", After: "float64"}},
+		{"Numbers", "Ints", TypeDiffEntry{Path: ".Terms", Kind: Changed, Before: "~int | ~float64", After: "~int"}},
+		{"IntSlice", "IntMap", TypeDiffEntry{Kind: Changed, Before: "[]int", After: "map[string]int"}},
+	} {
+		got := typeDiffOf(t, c, tc.a, tc.b)
+		if len(got) != 1 || got[0] != tc.want {
+			t.Errorf("TypeDiff(%s, %s) = %+v, want [%+v]", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
+
+func TestTypeDiffNested(t *testing.T) {
+	a := types.NewMap(types.Typ[types.String], types.NewSlice(types.Typ[types.Int]))
+	b := types.NewMap(types.Typ[types.String], types.NewSlice(types.Typ[types.Uint]))
+	want := []TypeDiffEntry{{Path: ".Elem.Elem", Kind: Changed, Before: "int", After: "uint"}}
+	if got := TypeDiff(a, b); !reflect.DeepEqual(got, want) {
+		t.Errorf("TypeDiff(%s, %s) = %+v, want %+v", a, b, got, want)
+	}
+}
+
+func TestTypeDiffDirective(t *testing.T) {
+	out := inspect(t, typeDiffSource+"\n// typediff: MyStruct MyStructV2\n// typediff: MyStruct MyStruct\n// typediff: MyStruct Missing\nfunc main() {}\n")
+	for _, want := range []string{
+		"\t+ .Tags: Tags []string\n",
+		"\t~ .Count: int -> int64\n",
+		"\t+ .Methods[1]: Len(n int) int\n",
+		"\t<no differences>\n",
+		"\t<type Missing not found>\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output does not contain %q:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"fmt"
+	"go/types"
+	"io"
+	"strings"
+)
+
+// TypeHierarchy records which named types of a package implement which
+// named interfaces.
+type TypeHierarchy struct {
+	pkg        *types.Package
+	types      []*types.Named
+	interfaces []*types.Named
+	implements map[*types.Named][]*types.Named // Type to the interfaces it implements
+}
+
+// BuildTypeHierarchy checks every non-generic named type declared at package
+// level in pkg against every named interface declared in pkg or exported by
+// a package it imports, such as fmt.Stringer. A type implements an
+// interface if its value or pointer method set does. Interfaces without
+// methods are left out since every type implements them, and so are
+// constraint interfaces, which no type implements.
+func BuildTypeHierarchy(pkg *types.Package) *TypeHierarchy {
+	h := &TypeHierarchy{pkg: pkg, implements: make(map[*types.Named][]*types.Named)}
+	collect := func(scope *types.Scope, exportedOnly bool) {
+		for _, name := range scope.Names() {
+			tn, ok := scope.Lookup(name).(*types.TypeName)
+			if !ok || tn.IsAlias() || exportedOnly && !tn.Exported() {
+				continue
+			}
+			named, ok := tn.Type().(*types.Named)
+			if !ok || named.TypeParams() != nil {
+				continue
+			}
+			iface, isIface := named.Underlying().(*types.Interface)
+			switch {
+			case !isIface && !exportedOnly:
+				h.types = append(h.types, named)
+			case isIface && iface.IsMethodSet() && iface.NumMethods() > 0:
+				h.interfaces = append(h.interfaces, named)
+			}
+		}
+	}
+	collect(pkg.Scope(), false)
+	for _, imp := range pkg.Imports() {
+		collect(imp.Scope(), true)
+	}
+
+	for _, t := range h.types {
+		for _, iface := range h.interfaces {
+			ifaceType := iface.Underlying().(*types.Interface)
+			if types.Implements(t, ifaceType) || types.Implements(types.NewPointer(t), ifaceType) {
+				h.implements[t] = append(h.implements[t], iface)
+			}
+		}
+	}
+	return h
+}
+
+// Implementors returns the types implementing iface, sorted by name.
+func (h *TypeHierarchy) Implementors(iface *types.Interface) []*types.Named {
+	var result []*types.Named
+	for _, t := range h.types {
+		for _, i := range h.implements[t] {
+			if types.Identical(i.Underlying(), iface) {
+				result = append(result, t)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// Interfaces returns the named interfaces implemented by t.
+func (h *TypeHierarchy) Interfaces(t *types.Named) []*types.Named {
+	return h.implements[t]
+}
+
+// WriteDOT writes the hierarchy in Graphviz DOT format with an edge from
+// every type to each interface it implements. Interfaces are drawn as
+// ellipses, all other types as boxes. Imported interfaces that no type
+// implements are left out to keep the graph readable.
+func (h *TypeHierarchy) WriteDOT(w io.Writer) error {
+	buff := &strings.Builder{}
+	buff.WriteString("digraph types {\n\tnode [shape=box];\n")
+	for _, t := range h.types {
+		fmt.Fprintf(buff, "\t%q;\n", NormalizeTypeName(t, h.pkg))
+	}
+	for _, iface := range h.interfaces {
+		if iface.Obj().Pkg() == h.pkg || len(h.Implementors(iface.Underlying().(*types.Interface))) > 0 {
+			fmt.Fprintf(buff, "\t%q [shape=ellipse];\n", NormalizeTypeName(iface, h.pkg))
+		}
+	}
+	for _, t := range h.types {
+		for _, iface := range h.implements[t] {
+			fmt.Fprintf(buff, "\t%q -> %q;\n", NormalizeTypeName(t, h.pkg), NormalizeTypeName(iface, h.pkg))
+		}
+	}
+	buff.WriteString("}\n")
+	_, err := io.WriteString(w, buff.String())
+	return err
+}
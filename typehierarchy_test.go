@@ -0,0 +1,110 @@
+package main
+
+import (
+	"go/types"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// hierarchySource returns example1.go.e importing fmt, with extra
+// declarations appended.
+func hierarchySource(t *testing.T, extra string) string {
+	t.Helper()
+	example := strings.TrimPrefix(readExample(t, "example1.go.e"), "package main\n")
+	return "package main\n\nimport \"fmt\"\n\nvar _ = fmt.Sprint\n" + example + extra
+}
+
+func namedType(t *testing.T, c checkedPackage, path, name string) *types.Named {
+	t.Helper()
+	pkg := c.pkg
+	for _, imp := range c.pkg.Imports() {
+		if imp.Path() == path {
+			pkg = imp
+		}
+	}
+	tn, ok := pkg.Scope().Lookup(name).(*types.TypeName)
+	if !ok {
+		t.Fatalf("type %s.%s not found", path, name)
+	}
+	return tn.Type().(*types.Named)
+}
+
+func typeNames(ts []*types.Named) []string {
+	var names []string
+	for _, t := range ts {
+		names = append(names, t.Obj().Name())
+	}
+	return names
+}
+
+func TestTypeHierarchyStringer(t *testing.T) {
+	before := checkSource(t, hierarchySource(t, ""))
+	h := BuildTypeHierarchy(before.pkg)
+	if got := h.Interfaces(namedType(t, before, "main", "MyInt")); len(got) != 0 {
+		t.Errorf("MyInt implements %v before adding String", typeNames(got))
+	}
+
+	after := checkSource(t, hierarchySource(t, "\nfunc (n MyInt) String() string { return fmt.Sprint(int(n)) }\n"))
+	h = BuildTypeHierarchy(after.pkg)
+	stringer := namedType(t, after, "fmt", "Stringer")
+	if got, want := typeNames(h.Interfaces(namedType(t, after, "main", "MyInt"))), []string{"Stringer"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Interfaces(MyInt) = %v, want %v", got, want)
+	}
+	if got, want := typeNames(h.Implementors(stringer.Underlying().(*types.Interface))), []string{"MyInt"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Implementors(fmt.Stringer) = %v, want %v", got, want)
+	}
+}
+
+const hierarchyExtra = `
+type Shape interface{ Area() float64 }
+
+type Resetter interface{ Reset() }
+
+type Number interface{ ~int | ~float64 }
+
+type Square struct{ side float64 }
+
+func (s Square) Area() float64 { return s.side * s.side }
+func (s *Square) Reset()       { s.side = 0 }
+
+type Pair[T any] struct{ a, b T }
+
+func (Pair[T]) Area() float64 { return 0 }
+`
+
+func TestTypeHierarchyLocalInterfaces(t *testing.T) {
+	c := checkSource(t, hierarchySource(t, hierarchyExtra))
+	h := BuildTypeHierarchy(c.pkg)
+	// Square implements Resetter through its pointer method set.
+	if got, want := typeNames(h.Interfaces(namedType(t, c, "main", "Square"))), []string{"Resetter", "Shape"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Interfaces(Square) = %v, want %v", got, want)
+	}
+	shape := namedType(t, c, "main", "Shape").Underlying().(*types.Interface)
+	if got, want := typeNames(h.Implementors(shape)), []string{"Square"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Implementors(Shape) = %v, want %v (generic types are left out)", got, want)
+	}
+
+	buff := &strings.Builder{}
+	if err := h.WriteDOT(buff); err != nil {
+		t.Fatal(err)
+	}
+	dot := buff.String()
+	for _, want := range []string{
+		"\t\"Square\";\n",
+		"\t\"Shape\" [shape=ellipse];\n",
+		"\t\"Square\" -> \"Resetter\";\n",
+		"\t\"Square\" -> \"Shape\";\n",
+	} {
+		if !strings.Contains(dot, want) {
+			t.Errorf("DOT output does not contain %q:\n%s", want, dot)
+		}
+	}
+	// Constraint interfaces and unimplemented imported interfaces are left
+	// out.
+	for _, unwanted := range []string{"Number", "fmt.Formatter", "Pair"} {
+		if strings.Contains(dot, unwanted) {
+			t.Errorf("DOT output contains %s:\n%s", unwanted, dot)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package main
+
+import "go/types"
+
+// TypeNameOptions controls how NormalizeTypeName spells types.
+type TypeNameOptions struct {
+	StripLocalPkg bool // Print types of the local package without qualifier
+	ExpandAliases bool // Replace aliases by the types they denote
+	ShortPkgPath  bool // Qualify with the package name instead of its path
+}
+
+// DefaultTypeNameOptions enables all options.
+var DefaultTypeNameOptions = TypeNameOptions{StripLocalPkg: true, ExpandAliases: true, ShortPkgPath: true}
+
+// NormalizeTypeName formats t as seen from pkg using DefaultTypeNameOptions,
+// e.g. MyInt instead of main.MyInt.
+func NormalizeTypeName(t types.Type, pkg *types.Package) string {
+	return DefaultTypeNameOptions.Normalize(t, pkg)
+}
+
+// Normalize formats t as seen from pkg.
+func (o TypeNameOptions) Normalize(t types.Type, pkg *types.Package) string {
+	if o.ExpandAliases {
+		t = expandAliases(t)
+	}
+	return types.TypeString(t, func(p *types.Package) string {
+		switch {
+		case o.StripLocalPkg && p == pkg:
+			return ""
+		case o.ShortPkgPath:
+			return p.Name()
+		}
+		return p.Path()
+	})
+}
+
+// expandAliases replaces all aliases within t by their actual types. Generic
+// signatures are returned unchanged, since their type parameters cannot be
+// rebound.
+func expandAliases(t types.Type) types.Type {
+	switch t := t.(type) {
+	case *types.Alias:
+		return expandAliases(types.Unalias(t))
+	case *types.Pointer:
+		return types.NewPointer(expandAliases(t.Elem()))
+	case *types.Slice:
+		return types.NewSlice(expandAliases(t.Elem()))
+	case *types.Array:
+		return types.NewArray(expandAliases(t.Elem()), t.Len())
+	case *types.Map:
+		return types.NewMap(expandAliases(t.Key()), expandAliases(t.Elem()))
+	case *types.Chan:
+		return types.NewChan(t.Dir(), expandAliases(t.Elem()))
+	case *types.Tuple:
+		return expandTuple(t)
+	case *types.Signature:
+		if t.TypeParams() != nil {
+			return t
+		}
+		return types.NewSignatureType(t.Recv(), nil, nil, expandTuple(t.Params()), expandTuple(t.Results()), t.Variadic())
+	case *types.Struct:
+		fields := make([]*types.Var, t.NumFields())
+		tags := make([]string, t.NumFields())
+		for i := range fields {
+			f := t.Field(i)
+			fields[i] = types.NewField(f.Pos(), f.Pkg(), f.Name(), expandAliases(f.Type()), f.Embedded())
+			tags[i] = t.Tag(i)
+		}
+		return types.NewStruct(fields, tags)
+	case *types.Named:
+		if t.TypeArgs().Len() == 0 {
+			return t
+		}
+		args := make([]types.Type, t.TypeArgs().Len())
+		for i := range args {
+			args[i] = expandAliases(t.TypeArgs().At(i))
+		}
+		if inst, err := types.Instantiate(nil, t.Origin(), args, false); err == nil {
+			return inst
+		}
+	}
+	return t
+}
+
+func expandTuple(t *types.Tuple) *types.Tuple {
+	if t == nil {
+		return nil
+	}
+	vars := make([]*types.Var, t.Len())
+	for i := range vars {
+		v := t.At(i)
+		vars[i] = types.NewParam(v.Pos(), v.Pkg(), v.Name(), expandAliases(v.Type()))
+	}
+	return types.NewTuple(vars...)
+}
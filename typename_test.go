@@ -0,0 +1,73 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+const typeNameSource = `package main
+
+import (
+	"go/token"
+	"strings"
+)
+
+type Ints = []MyInt
+
+type Box[T any] struct{ v T }
+
+var (
+	x      MyInt
+	s      MyStruct
+	even   = isEven
+	ptrs   map[string]*MyStruct
+	pos    token.Pos
+	b      *strings.Builder
+	alias  Ints
+	boxed  Box[Ints]
+	varfn  func(...Ints) error
+	anon   struct{ Items Ints }
+)
+`
+
+func TestNormalizeTypeName(t *testing.T) {
+	// The declarations of example1.go.e follow the imports of typeNameSource.
+	example := strings.TrimPrefix(readExample(t, "example1.go.e"), "package main\n")
+	c := checkSource(t, typeNameSource+example)
+	tests := []struct {
+		name                  string
+		want, noStrip, noExp  string
+		fullPath, allDisabled string
+	}{
+		{"x", "MyInt", "main.MyInt", "MyInt", "MyInt", "main.MyInt"},
+		{"s", "MyStruct", "main.MyStruct", "MyStruct", "MyStruct", "main.MyStruct"},
+		{"even", "func(n MyInt) bool", "func(n main.MyInt) bool", "func(n MyInt) bool", "func(n MyInt) bool", "func(n main.MyInt) bool"},
+		{"ptrs", "map[string]*MyStruct", "map[string]*main.MyStruct", "map[string]*MyStruct", "map[string]*MyStruct", "map[string]*main.MyStruct"},
+		{"pos", "token.Pos", "token.Pos", "token.Pos", "go/token.Pos", "go/token.Pos"},
+		{"b", "*strings.Builder", "*strings.Builder", "*strings.Builder", "*strings.Builder", "*strings.Builder"},
+		{"alias", "[]MyInt", "[]main.MyInt", "Ints", "[]MyInt", "main.Ints"},
+		{"boxed", "Box[[]MyInt]", "main.Box[[]main.MyInt]", "Box[Ints]", "Box[[]MyInt]", "main.Box[main.Ints]"},
+		{"varfn", "func(...[]MyInt) error", "func(...[]main.MyInt) error", "func(...Ints) error", "func(...[]MyInt) error", "func(...main.Ints) error"},
+		{"anon", "struct{Items []MyInt}", "struct{Items []main.MyInt}", "struct{Items Ints}", "struct{Items []MyInt}", "struct{Items main.Ints}"},
+	}
+	for _, tt := range tests {
+		typ := c.pkg.Scope().Lookup(tt.name).Type()
+		for _, o := range []struct {
+			opts TypeNameOptions
+			want string
+		}{
+			{DefaultTypeNameOptions, tt.want},
+			{TypeNameOptions{ExpandAliases: true, ShortPkgPath: true}, tt.noStrip},
+			{TypeNameOptions{StripLocalPkg: true, ShortPkgPath: true}, tt.noExp},
+			{TypeNameOptions{StripLocalPkg: true, ExpandAliases: true}, tt.fullPath},
+			{TypeNameOptions{}, tt.allDisabled},
+		} {
+			if got := o.opts.Normalize(typ, c.pkg); got != o.want {
+				t.Errorf("%+v.Normalize(type of %s) = %q, want %q", o.opts, tt.name, got, o.want)
+			}
+		}
+	}
+	if got := NormalizeTypeName(c.pkg.Scope().Lookup("x").Type(), c.pkg); got != "MyInt" {
+		t.Errorf("NormalizeTypeName(MyInt) = %q, want %q", got, "MyInt")
+	}
+}
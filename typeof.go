@@ -0,0 +1,35 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+)
+
+// typeOf type-checks the expression args as if it were written at pos.
+func typeOf(c checkedPackage, pos token.Pos, _ *types.Scope, args string) string {
+	expr, err := parser.ParseExpr(args)
+	if err != nil {
+		return fmt.Sprintf("\t<invalid expression: %v>\n", err)
+	}
+	// Record into a separate Info, so the expression does not show up in
+	// the Uses of the checked files.
+	info := &types.Info{Types: make(map[ast.Expr]types.TypeAndValue)}
+	if err := types.CheckExpr(c.fset, c.pkg, pos, expr, info); err != nil {
+		return fmt.Sprintf("\t<%v>\n", err)
+	}
+	tv := info.Types[expr]
+
+	buff := &strings.Builder{}
+	fmt.Fprintf(buff, "\tType: %s\n", tv.Type.String())
+	underlying := tv.Type.Underlying()
+	fmt.Fprintf(buff, "\tUnderlying Type: %T %s\n", underlying, underlying.String())
+	fmt.Fprintf(buff, "\tAddressable: %v\n", tv.Addressable())
+	if tv.Value != nil {
+		fmt.Fprintf(buff, "\tConstant Value: %s\n", tv.Value.String())
+	}
+	return buff.String()
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"sort"
+	"strings"
+)
+
+// FindUsages returns the positions of every identifier that declares or
+// refers to obj, in source order.
+func FindUsages(fset *token.FileSet, info *types.Info, obj types.Object) []token.Position {
+	var idents []*ast.Ident
+	for id, def := range info.Defs {
+		if def == obj {
+			idents = append(idents, id)
+		}
+	}
+	for id, use := range info.Uses {
+		if use == obj {
+			idents = append(idents, id)
+		}
+	}
+	sort.Slice(idents, func(i, j int) bool { return idents[i].Pos() < idents[j].Pos() })
+
+	positions := make([]token.Position, len(idents))
+	for i, id := range idents {
+		positions[i] = fset.Position(id.Pos())
+	}
+	return positions
+}
+
+// usages lists where the named object is declared and used.
+func usages(c checkedPackage, pos token.Pos, scope *types.Scope, args string) string {
+	name := strings.TrimSpace(args)
+	obj, _ := lookupSelector(c.pkg, scope, pos, name)
+	if obj == nil {
+		return fmt.Sprintf("\t<%s not found>\n", name)
+	}
+	buff := &strings.Builder{}
+	for _, p := range FindUsages(c.fset, c.info, obj) {
+		fmt.Fprintf(buff, "\t%s\n", p)
+	}
+	return buff.String()
+}
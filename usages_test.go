@@ -0,0 +1,37 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFindUsages(t *testing.T) {
+	c := checkSource(t, readExample(t, "example1.go.e"))
+	tests := []struct {
+		name string
+		want []string
+	}{
+		// Declaration, parameter type and both conversions in main.
+		{"MyInt", []string{"input.go:3:6", "input.go:5:15", "input.go:15:7", "input.go:16:6"}},
+		{"isEven", []string{"input.go:5:6", "input.go:22:6"}},
+	}
+	for _, tt := range tests {
+		obj := c.pkg.Scope().Lookup(tt.name)
+		var got []string
+		for _, p := range FindUsages(c.fset, c.info, obj) {
+			got = append(got, p.String())
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("FindUsages(%s) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestUsagesDirective(t *testing.T) {
+	out := inspect(t, readExample(t, "example1.go.e"))
+	want := "input.go:20:2,\tusages \"isEven\"\n\tinput.go:5:6\n\tinput.go:22:6\n"
+	if !strings.Contains(out, want) {
+		t.Errorf("output does not contain\n%s\ngot:\n%s", want, out)
+	}
+}
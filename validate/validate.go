@@ -0,0 +1,160 @@
+// Package validate provides composable, typed validators.
+package validate
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"strings"
+
+	"projektarbeit-go-generics/numeric"
+)
+
+// Validator checks values of type T.
+type Validator[T any] interface {
+	Validate(T) error
+}
+
+// ValidatorFunc adapts a function to a Validator.
+type ValidatorFunc[T any] func(T) error
+
+// Validate calls f(v).
+func (f ValidatorFunc[T]) Validate(v T) error {
+	return f(v)
+}
+
+// ValidationError describes a single failed check. Path locates the
+// offending value, e.g. "[2].Address.Zip", and is empty for the value
+// itself.
+type ValidationError struct {
+	Path    string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Path == "" {
+		return e.Message
+	}
+	return e.Path + ": " + e.Message
+}
+
+// And returns a validator that passes if all validators pass. It runs every
+// validator and joins their errors.
+func And[T any](validators ...Validator[T]) Validator[T] {
+	return ValidatorFunc[T](func(v T) error {
+		var errs []error
+		for _, val := range validators {
+			if err := val.Validate(v); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return errors.Join(errs...)
+	})
+}
+
+// Or returns a validator that passes if at least one of validators passes.
+// If none does, their errors are joined. Or without validators always fails.
+func Or[T any](validators ...Validator[T]) Validator[T] {
+	return ValidatorFunc[T](func(v T) error {
+		if len(validators) == 0 {
+			return &ValidationError{Message: "no validator passed"}
+		}
+		var errs []error
+		for _, val := range validators {
+			err := val.Validate(v)
+			if err == nil {
+				return nil
+			}
+			errs = append(errs, err)
+		}
+		return errors.Join(errs...)
+	})
+}
+
+// Not returns a validator that passes if v fails.
+func Not[T any](v Validator[T]) Validator[T] {
+	return ValidatorFunc[T](func(x T) error {
+		if v.Validate(x) == nil {
+			return &ValidationError{Message: "must not pass validation"}
+		}
+		return nil
+	})
+}
+
+// Field validates the part of T returned by get and prefixes the paths of
+// all errors with name.
+func Field[T, F any](name string, get func(T) F, v Validator[F]) Validator[T] {
+	return ValidatorFunc[T](func(x T) error {
+		return withPath(name, v.Validate(get(x)))
+	})
+}
+
+// ValidateAll validates every item and returns all errors. Their paths are
+// prefixed with the index of the item, e.g. "[3].Name".
+func ValidateAll[T any](items []T, v Validator[T]) []error {
+	var errs []error
+	for i, item := range items {
+		if err := withPath(fmt.Sprintf("[%d]", i), v.Validate(item)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// withPath prefixes the path of every ValidationError in err with prefix. A
+// plain error is turned into a ValidationError at prefix.
+func withPath(prefix string, err error) error {
+	if err == nil {
+		return nil
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		var errs []error
+		for _, e := range joined.Unwrap() {
+			errs = append(errs, withPath(prefix, e))
+		}
+		return errors.Join(errs...)
+	}
+	var ve *ValidationError
+	if !errors.As(err, &ve) {
+		return &ValidationError{Path: prefix, Message: err.Error()}
+	}
+	path := prefix
+	switch {
+	case ve.Path == "":
+	case strings.HasPrefix(ve.Path, "["):
+		path += ve.Path
+	default:
+		path += "." + ve.Path
+	}
+	return &ValidationError{Path: path, Message: ve.Message}
+}
+
+// NonEmpty fails for the empty string.
+func NonEmpty[S ~string]() Validator[S] {
+	return ValidatorFunc[S](func(s S) error {
+		if s == "" {
+			return &ValidationError{Message: "must not be empty"}
+		}
+		return nil
+	})
+}
+
+// Positive fails for zero and negative numbers.
+func Positive[N numeric.Numeric]() Validator[N] {
+	return ValidatorFunc[N](func(n N) error {
+		if !(n > 0) {
+			return &ValidationError{Message: fmt.Sprintf("must be positive, got %v", n)}
+		}
+		return nil
+	})
+}
+
+// InRange fails for values outside [lo, hi].
+func InRange[T cmp.Ordered](lo, hi T) Validator[T] {
+	return ValidatorFunc[T](func(v T) error {
+		if v < lo || v > hi {
+			return &ValidationError{Message: fmt.Sprintf("must be in [%v, %v], got %v", lo, hi, v)}
+		}
+		return nil
+	})
+}
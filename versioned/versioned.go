@@ -0,0 +1,113 @@
+// Package versioned provides a value that remembers its previous versions.
+package versioned
+
+import (
+	"fmt"
+	"slices"
+
+	"projektarbeit-go-generics/collections"
+)
+
+// Option configures a Versioned value.
+type Option func(*options)
+
+type options struct {
+	maxHistory int
+}
+
+// WithMaxHistory keeps at most n previous versions and discards the oldest
+// ones beyond that. It panics if n is not positive.
+func WithMaxHistory(n int) Option {
+	if n <= 0 {
+		panic(fmt.Sprintf("versioned: invalid max history %d", n))
+	}
+	return func(o *options) {
+		o.maxHistory = n
+	}
+}
+
+// Versioned holds a current value and the values it replaced. The history
+// is unbounded unless WithMaxHistory is given.
+type Versioned[T any] struct {
+	current T
+	history []T                  // unbounded history, oldest first
+	ring    *collections.Ring[T] // bounded history, if WithMaxHistory was given
+}
+
+// NewVersioned returns a value with no history whose current version is
+// initial.
+func NewVersioned[T any](initial T, opts ...Option) *Versioned[T] {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	v := &Versioned[T]{current: initial}
+	if o.maxHistory > 0 {
+		v.ring = collections.NewRing[T](o.maxHistory)
+	}
+	return v
+}
+
+// Current returns the current version.
+func (v *Versioned[T]) Current() T {
+	return v.current
+}
+
+// Set moves the current version to the history and replaces it with x.
+func (v *Versioned[T]) Set(x T) {
+	if v.ring != nil {
+		v.ring.PushOverwrite(v.current)
+	} else {
+		v.history = append(v.history, v.current)
+	}
+	v.current = x
+}
+
+// History returns a copy of the previous versions, oldest first.
+func (v *Versioned[T]) History() []T {
+	if v.ring != nil {
+		return slices.Collect(v.ring.All())
+	}
+	return slices.Clone(v.history)
+}
+
+// Len returns the number of previous versions.
+func (v *Versioned[T]) Len() int {
+	if v.ring != nil {
+		return v.ring.Len()
+	}
+	return len(v.history)
+}
+
+// Rollback restores the previous version. It returns false and leaves the
+// value unchanged if there is no history.
+func (v *Versioned[T]) Rollback() bool {
+	if v.ring != nil {
+		prev, ok := v.ring.PopBack()
+		if ok {
+			v.current = prev
+		}
+		return ok
+	}
+	if len(v.history) == 0 {
+		return false
+	}
+	last := len(v.history) - 1
+	v.current = v.history[last]
+	var zero T
+	v.history[last] = zero
+	v.history = v.history[:last]
+	return true
+}
+
+// Undo rolls back n versions. It returns false and leaves the value
+// unchanged if n is negative or exceeds the history.
+func (v *Versioned[T]) Undo(n int) bool {
+	if n < 0 || n > v.Len() {
+		return false
+	}
+	for range n {
+		v.Rollback()
+	}
+	return true
+}